@@ -0,0 +1,88 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+// UpdateBits is same as UpdateCrc, except it processes first nbits bits of data, which do not have to make
+// whole bytes, as CAN, Bluetooth LE, Mode S and other link layer protocols need. Bits of each byte are taken
+// starting from the most significant one if msbFirst is set, or from the least significant one otherwise,
+// and the last byte may be used partially. So t.UpdateBits(state, p, 8*len(p), !ReflectIn) is the same
+// as t.UpdateCrc(state, p), and CalculateCRC processes bits in the same order.
+//
+// Whole bytes are processed by the table (reversing their bit order first if needed), and the remaining
+// nbits%8 bits bit by bit. nbits must be between 0 and 8*len(data), otherwise UpdateBits panics.
+func (t *Table) UpdateBits(state uint64, data []byte, nbits int, msbFirst bool) uint64 {
+	if nbits < 0 || nbits > 8*len(data) {
+		panic("crc: UpdateBits called with nbits out of range of data")
+	}
+	whole := data[:nbits/8]
+	if msbFirst != t.crcParams.ReflectIn {
+		state = t.UpdateCrc(state, whole)
+	} else {
+		var buf [256]byte
+		for len(whole) > 0 {
+			n := copy(buf[:], whole)
+			for i, b := range buf[:n] {
+				buf[i] = byte(reflect(uint64(b), 8))
+			}
+			state = t.UpdateCrc(state, buf[:n])
+			whole = whole[n:]
+		}
+	}
+
+	rest := uint(nbits % 8)
+	if rest == 0 {
+		return state
+	}
+	last := data[nbits/8]
+	register := newBitRegister(&t.crcParams)
+	for i := uint(0); i < rest; i++ {
+		if msbFirst {
+			state = register.update(state, last&(0x80>>i) != 0)
+		} else {
+			state = register.update(state, last&(1<<i) != 0)
+		}
+	}
+	return state
+}
+
+// bitRegister processes input bit by bit, keeping the register the same way as Table keeps it,
+// i.e. reflected if ReflectIn is set.
+type bitRegister struct {
+	poly      uint64 // polynomial, reflected if ReflectIn is set
+	mask      uint64
+	topBit    uint64
+	reflected bool
+}
+
+func newBitRegister(crcParams *Parameters) bitRegister {
+	r := bitRegister{
+		poly:      crcParams.Polynomial,
+		mask:      widthMask(crcParams.Width),
+		topBit:    uint64(1) << (crcParams.Width - 1),
+		reflected: crcParams.ReflectIn,
+	}
+	if r.reflected {
+		r.poly = reflect(r.poly, crcParams.Width)
+	}
+	return r
+}
+
+// update processes a single bit
+func (r bitRegister) update(state uint64, bit bool) uint64 {
+	if r.reflected {
+		feedback := (state&1 != 0) != bit
+		state >>= 1
+		if feedback {
+			state ^= r.poly
+		}
+		return state
+	}
+	feedback := (state&r.topBit != 0) != bit
+	state = (state << 1) & r.mask
+	if feedback {
+		state ^= r.poly
+	}
+	return state
+}
@@ -0,0 +1,83 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import "testing"
+
+// calculateBits calculates CRC over bits bit by bit the same way as CalculateCRC does, with each bit in a separate byte
+func calculateBits(params *Parameters, bits []byte) uint64 {
+	crc := params.Init
+	topBit := uint64(1) << (params.Width - 1)
+	for _, b := range bits {
+		feedback := (crc&topBit != 0) != (b != 0)
+		crc = (crc << 1) & widthMask(params.Width)
+		if feedback {
+			crc ^= params.Polynomial
+		}
+	}
+	if params.ReflectOut {
+		crc = reflect(crc, params.Width)
+	}
+	return (crc ^ params.FinalXor) & widthMask(params.Width)
+}
+
+func TestUpdateBits(t *testing.T) {
+	data := []byte("bits are not always aligned to bytes, e.g. CAN frames")
+	for _, entry := range catalogue {
+		params := entry.params
+		table := NewTable(params)
+
+		whole := table.CRC(table.UpdateBits(table.InitCrc(), data, 8*len(data), !params.ReflectIn))
+		if expected := table.CalculateCRC(data); whole != expected {
+			t.Errorf("Incorrect %s CRC 0x%X of whole bytes (should be 0x%X)", params.Name, whole, expected)
+		}
+
+		for _, msbFirst := range []bool{true, false} {
+			var bits []byte
+			for _, b := range data {
+				for i := uint(0); i < 8; i++ {
+					if msbFirst {
+						bits = append(bits, b&(0x80>>i))
+					} else {
+						bits = append(bits, b&(1<<i))
+					}
+				}
+			}
+			for _, nbits := range []int{0, 1, 5, 8, 83, 8*len(data) - 3} {
+				expected := calculateBits(params, bits[:nbits])
+				crc := table.CRC(table.UpdateBits(table.InitCrc(), data, nbits, msbFirst))
+				if crc != expected {
+					t.Errorf("Incorrect %s CRC 0x%X of %d bits, msbFirst %v (should be 0x%X)", params.Name, crc, nbits, msbFirst, expected)
+				}
+
+				// split into two calls of Hash
+				h := NewHash(params)
+				split := nbits / 3
+				h.UpdateBits(data, split, msbFirst)
+				for i := 0; i < nbits-split; i++ {
+					var b byte
+					if bits[split+i] != 0 {
+						b = 0xFF
+					}
+					h.UpdateBits([]byte{b}, 1, msbFirst)
+				}
+				if crc := h.CRC(); crc != expected {
+					t.Errorf("Incorrect %s CRC 0x%X of %d bits fed by Hash in parts, msbFirst %v (should be 0x%X)", params.Name, crc, nbits, msbFirst, expected)
+				}
+			}
+		}
+	}
+
+	for _, nbits := range []int{-1, 8*len(data) + 1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("UpdateBits did not panic for %d bits of %d byte data", nbits, len(data))
+				}
+			}()
+			NewTable(CRC32).UpdateBits(0, data, nbits, true)
+		}()
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"container/list"
+	"sync"
+)
+
+// tableCacheSize is the maximum number of tables kept in the cache. Once it is full,
+// the least recently used table is evicted to make room for a new one.
+const tableCacheSize = 1024
+
+// tableKey identifies the contents of the table, which only depends on Width, Polynomial and ReflectIn
+type tableKey struct {
+	width     uint
+	poly      uint64
+	reflectIn bool
+}
+
+// tableCacheEntry is an element of tableCache.order
+type tableCacheEntry struct {
+	key   tableKey
+	table []uint64
+}
+
+// tableCache keeps tables built by NewTable for parameters not covered by staticTables, so that Tables
+// for the same algorithm (or algorithms differing only in Init, FinalXor and so on) share them.
+// Tables are never modified once built, so they can be shared freely.
+var tableCache = struct {
+	sync.Mutex
+	entries map[tableKey]*list.Element
+	order   *list.List // of *tableCacheEntry, most recently used first
+}{entries: make(map[tableKey]*list.Element), order: list.New()}
+
+// cachedTable returns a table built earlier for key, if any
+func cachedTable(key tableKey) ([]uint64, bool) {
+	tableCache.Lock()
+	defer tableCache.Unlock()
+	if elem, ok := tableCache.entries[key]; ok {
+		tableCache.order.MoveToFront(elem)
+		return elem.Value.(*tableCacheEntry).table, true
+	}
+	return nil, false
+}
+
+// cacheTable adds table built for key to the cache, evicting the least recently used one if it is full.
+// If another table for the same key has been added concurrently, it returns that table, otherwise table itself.
+func cacheTable(key tableKey, table []uint64) []uint64 {
+	tableCache.Lock()
+	defer tableCache.Unlock()
+	if elem, ok := tableCache.entries[key]; ok {
+		tableCache.order.MoveToFront(elem)
+		return elem.Value.(*tableCacheEntry).table
+	}
+	if tableCache.order.Len() >= tableCacheSize {
+		oldest := tableCache.order.Back()
+		tableCache.order.Remove(oldest)
+		delete(tableCache.entries, oldest.Value.(*tableCacheEntry).key)
+	}
+	tableCache.entries[key] = tableCache.order.PushFront(&tableCacheEntry{key, table})
+	return table
+}
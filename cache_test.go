@@ -0,0 +1,67 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestTableCache(t *testing.T) {
+	custom := &Parameters{Width: 13, Polynomial: 0x1CF5, Init: 0x1234, ReflectIn: true, ReflectOut: false}
+	renamed := *custom
+	renamed.Name = "CUSTOM"
+	renamed.Init = 0
+	if a, b := NewTable(custom), NewTable(&renamed); a == b || &a.crctable[0] != &b.crctable[0] {
+		t.Errorf("NewTable did not share table between different Tables of the same polynomial")
+	}
+	other := *custom
+	other.ReflectIn = false
+	if table := NewTable(&other); &table.crctable[0] == &NewTable(custom).crctable[0] {
+		t.Errorf("NewTable shared table for different parameters")
+	}
+	for _, params := range []*Parameters{custom, &renamed, &other} {
+		if crc, expected := NewTable(params).CalculateCRC(checkInput), CalculateCRC(params, checkInput); crc != expected {
+			t.Errorf("Incorrect CRC 0x%04x calculated with cached table (should be 0x%04x)", crc, expected)
+		}
+	}
+
+	// concurrent use
+	tables := make([]*Table, 16)
+	var wg sync.WaitGroup
+	for i := range tables {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tables[i] = NewTable(&Parameters{Width: 17, Polynomial: 0x1685B, Init: uint64(i)})
+		}(i)
+	}
+	wg.Wait()
+	for i, table := range tables {
+		if crc, expected := table.CalculateCRC(checkInput), CalculateCRC(&table.crcParams, checkInput); crc != expected {
+			t.Errorf("Incorrect CRC 0x%05x calculated by table %d created concurrently (should be 0x%05x)", crc, i, expected)
+		}
+	}
+
+	// least recently used tables are evicted once the cache is full
+	for i := 0; i < tableCacheSize+100; i++ {
+		NewTable(&Parameters{Width: 32, Polynomial: uint64(2*i + 1)})
+	}
+	tableCache.Lock()
+	size := tableCache.order.Len()
+	_, oldest := tableCache.entries[tableKey{32, 1, false}]
+	_, newest := tableCache.entries[tableKey{32, 2*(tableCacheSize+99) + 1, false}]
+	tableCache.Unlock()
+	if size != tableCacheSize || oldest || !newest {
+		t.Errorf("Incorrect cache state after overflow: %d tables, oldest kept %v, newest kept %v", size, oldest, newest)
+	}
+
+	// one-off tables used internally are not cached
+	oneOff := &Parameters{Width: 31, Polynomial: 0x4C11DB7}
+	newOneOffTable(oneOff)
+	if _, ok := cachedTable(tableKey{31, 0x4C11DB7, false}); ok {
+		t.Errorf("One-off table was added to the cache")
+	}
+}
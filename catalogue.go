@@ -0,0 +1,392 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import "strings"
+
+// Parameter sets below follow the CRC RevEng catalogue (http://reveng.sourceforge.net/crc-catalogue/),
+// and are named after their canonical names there. Use Lookup to find a parameter set by its
+// canonical name or any of its aliases.
+var (
+	// CRC3GSM is CRC-3/GSM
+	CRC3GSM = &Parameters{Name: "CRC-3/GSM", Width: 3, Polynomial: 0x3, Init: 0x0, ReflectIn: false, ReflectOut: false, FinalXor: 0x7, Check: 0x4, Residue: 0x2}
+	// CRC3ROHC is CRC-3/ROHC
+	CRC3ROHC = &Parameters{Name: "CRC-3/ROHC", Width: 3, Polynomial: 0x3, Init: 0x7, ReflectIn: true, ReflectOut: true, FinalXor: 0x0, Check: 0x6, Residue: 0x0}
+	// CRC4G704 is CRC-4/G-704, also known as CRC-4/ITU
+	CRC4G704 = &Parameters{Name: "CRC-4/G-704", Width: 4, Polynomial: 0x3, Init: 0x0, ReflectIn: true, ReflectOut: true, FinalXor: 0x0, Check: 0x7, Residue: 0x0}
+	// CRC4INTERLAKEN is CRC-4/INTERLAKEN
+	CRC4INTERLAKEN = &Parameters{Name: "CRC-4/INTERLAKEN", Width: 4, Polynomial: 0x3, Init: 0xF, ReflectIn: false, ReflectOut: false, FinalXor: 0xF, Check: 0xB, Residue: 0x2}
+	// CRC5EPCC1G2 is CRC-5/EPC-C1G2, also known as CRC-5/EPC
+	CRC5EPCC1G2 = &Parameters{Name: "CRC-5/EPC-C1G2", Width: 5, Polynomial: 0x09, Init: 0x09, ReflectIn: false, ReflectOut: false, FinalXor: 0x00, Check: 0x00, Residue: 0x00}
+	// CRC5G704 is CRC-5/G-704, also known as CRC-5/ITU
+	CRC5G704 = &Parameters{Name: "CRC-5/G-704", Width: 5, Polynomial: 0x15, Init: 0x00, ReflectIn: true, ReflectOut: true, FinalXor: 0x00, Check: 0x07, Residue: 0x00}
+	// CRC5USB is CRC-5/USB
+	CRC5USB = &Parameters{Name: "CRC-5/USB", Width: 5, Polynomial: 0x05, Init: 0x1F, ReflectIn: true, ReflectOut: true, FinalXor: 0x1F, Check: 0x19, Residue: 0x06}
+	// CRC6CDMA2000A is CRC-6/CDMA2000-A
+	CRC6CDMA2000A = &Parameters{Name: "CRC-6/CDMA2000-A", Width: 6, Polynomial: 0x27, Init: 0x3F, ReflectIn: false, ReflectOut: false, FinalXor: 0x00, Check: 0x0D, Residue: 0x00}
+	// CRC6CDMA2000B is CRC-6/CDMA2000-B
+	CRC6CDMA2000B = &Parameters{Name: "CRC-6/CDMA2000-B", Width: 6, Polynomial: 0x07, Init: 0x3F, ReflectIn: false, ReflectOut: false, FinalXor: 0x00, Check: 0x3B, Residue: 0x00}
+	// CRC6DARC is CRC-6/DARC
+	CRC6DARC = &Parameters{Name: "CRC-6/DARC", Width: 6, Polynomial: 0x19, Init: 0x00, ReflectIn: true, ReflectOut: true, FinalXor: 0x00, Check: 0x26, Residue: 0x00}
+	// CRC6G704 is CRC-6/G-704, also known as CRC-6/ITU
+	CRC6G704 = &Parameters{Name: "CRC-6/G-704", Width: 6, Polynomial: 0x03, Init: 0x00, ReflectIn: true, ReflectOut: true, FinalXor: 0x00, Check: 0x06, Residue: 0x00}
+	// CRC6GSM is CRC-6/GSM
+	CRC6GSM = &Parameters{Name: "CRC-6/GSM", Width: 6, Polynomial: 0x2F, Init: 0x00, ReflectIn: false, ReflectOut: false, FinalXor: 0x3F, Check: 0x13, Residue: 0x3A}
+	// CRC7MMC is CRC-7/MMC, also known as CRC-7
+	CRC7MMC = &Parameters{Name: "CRC-7/MMC", Width: 7, Polynomial: 0x09, Init: 0x00, ReflectIn: false, ReflectOut: false, FinalXor: 0x00, Check: 0x75, Residue: 0x00}
+	// CRC7ROHC is CRC-7/ROHC
+	CRC7ROHC = &Parameters{Name: "CRC-7/ROHC", Width: 7, Polynomial: 0x4F, Init: 0x7F, ReflectIn: true, ReflectOut: true, FinalXor: 0x00, Check: 0x53, Residue: 0x00}
+	// CRC7UMTS is CRC-7/UMTS
+	CRC7UMTS = &Parameters{Name: "CRC-7/UMTS", Width: 7, Polynomial: 0x45, Init: 0x00, ReflectIn: false, ReflectOut: false, FinalXor: 0x00, Check: 0x61, Residue: 0x00}
+	// CRC8AUTOSAR is CRC-8/AUTOSAR
+	CRC8AUTOSAR = &Parameters{Name: "CRC-8/AUTOSAR", Width: 8, Polynomial: 0x2F, Init: 0xFF, ReflectIn: false, ReflectOut: false, FinalXor: 0xFF, Check: 0xDF, Residue: 0x42}
+	// CRC8BLUETOOTH is CRC-8/BLUETOOTH
+	CRC8BLUETOOTH = &Parameters{Name: "CRC-8/BLUETOOTH", Width: 8, Polynomial: 0xA7, Init: 0x00, ReflectIn: true, ReflectOut: true, FinalXor: 0x00, Check: 0x26, Residue: 0x00}
+	// CRC8CDMA2000 is CRC-8/CDMA2000
+	CRC8CDMA2000 = &Parameters{Name: "CRC-8/CDMA2000", Width: 8, Polynomial: 0x9B, Init: 0xFF, ReflectIn: false, ReflectOut: false, FinalXor: 0x00, Check: 0xDA, Residue: 0x00}
+	// CRC8DARC is CRC-8/DARC
+	CRC8DARC = &Parameters{Name: "CRC-8/DARC", Width: 8, Polynomial: 0x39, Init: 0x00, ReflectIn: true, ReflectOut: true, FinalXor: 0x00, Check: 0x15, Residue: 0x00}
+	// CRC8DVBS2 is CRC-8/DVB-S2
+	CRC8DVBS2 = &Parameters{Name: "CRC-8/DVB-S2", Width: 8, Polynomial: 0xD5, Init: 0x00, ReflectIn: false, ReflectOut: false, FinalXor: 0x00, Check: 0xBC, Residue: 0x00}
+	// CRC8GSMA is CRC-8/GSM-A
+	CRC8GSMA = &Parameters{Name: "CRC-8/GSM-A", Width: 8, Polynomial: 0x1D, Init: 0x00, ReflectIn: false, ReflectOut: false, FinalXor: 0x00, Check: 0x37, Residue: 0x00}
+	// CRC8GSMB is CRC-8/GSM-B
+	CRC8GSMB = &Parameters{Name: "CRC-8/GSM-B", Width: 8, Polynomial: 0x49, Init: 0x00, ReflectIn: false, ReflectOut: false, FinalXor: 0xFF, Check: 0x94, Residue: 0x53}
+	// CRC8HITAG is CRC-8/HITAG
+	CRC8HITAG = &Parameters{Name: "CRC-8/HITAG", Width: 8, Polynomial: 0x1D, Init: 0xFF, ReflectIn: false, ReflectOut: false, FinalXor: 0x00, Check: 0xB4, Residue: 0x00}
+	// CRC8I4321 is CRC-8/I-432-1, also known as CRC-8/ITU
+	CRC8I4321 = &Parameters{Name: "CRC-8/I-432-1", Width: 8, Polynomial: 0x07, Init: 0x00, ReflectIn: false, ReflectOut: false, FinalXor: 0x55, Check: 0xA1, Residue: 0xAC}
+	// CRC8ICODE is CRC-8/I-CODE
+	CRC8ICODE = &Parameters{Name: "CRC-8/I-CODE", Width: 8, Polynomial: 0x1D, Init: 0xFD, ReflectIn: false, ReflectOut: false, FinalXor: 0x00, Check: 0x7E, Residue: 0x00}
+	// CRC8LTE is CRC-8/LTE
+	CRC8LTE = &Parameters{Name: "CRC-8/LTE", Width: 8, Polynomial: 0x9B, Init: 0x00, ReflectIn: false, ReflectOut: false, FinalXor: 0x00, Check: 0xEA, Residue: 0x00}
+	// CRC8MAXIMDOW is CRC-8/MAXIM-DOW, also known as CRC-8/MAXIM, DOW-CRC
+	CRC8MAXIMDOW = &Parameters{Name: "CRC-8/MAXIM-DOW", Width: 8, Polynomial: 0x31, Init: 0x00, ReflectIn: true, ReflectOut: true, FinalXor: 0x00, Check: 0xA1, Residue: 0x00}
+	// CRC8MIFAREMAD is CRC-8/MIFARE-MAD
+	CRC8MIFAREMAD = &Parameters{Name: "CRC-8/MIFARE-MAD", Width: 8, Polynomial: 0x1D, Init: 0xC7, ReflectIn: false, ReflectOut: false, FinalXor: 0x00, Check: 0x99, Residue: 0x00}
+	// CRC8NRSC5 is CRC-8/NRSC-5
+	CRC8NRSC5 = &Parameters{Name: "CRC-8/NRSC-5", Width: 8, Polynomial: 0x31, Init: 0xFF, ReflectIn: false, ReflectOut: false, FinalXor: 0x00, Check: 0xF7, Residue: 0x00}
+	// CRC8OPENSAFETY is CRC-8/OPENSAFETY
+	CRC8OPENSAFETY = &Parameters{Name: "CRC-8/OPENSAFETY", Width: 8, Polynomial: 0x2F, Init: 0x00, ReflectIn: false, ReflectOut: false, FinalXor: 0x00, Check: 0x3E, Residue: 0x00}
+	// CRC8ROHC is CRC-8/ROHC
+	CRC8ROHC = &Parameters{Name: "CRC-8/ROHC", Width: 8, Polynomial: 0x07, Init: 0xFF, ReflectIn: true, ReflectOut: true, FinalXor: 0x00, Check: 0xD0, Residue: 0x00}
+	// CRC8SAEJ1850 is CRC-8/SAE-J1850
+	CRC8SAEJ1850 = &Parameters{Name: "CRC-8/SAE-J1850", Width: 8, Polynomial: 0x1D, Init: 0xFF, ReflectIn: false, ReflectOut: false, FinalXor: 0xFF, Check: 0x4B, Residue: 0xC4}
+	// CRC8SMBUS is CRC-8/SMBUS, also known as CRC-8
+	CRC8SMBUS = &Parameters{Name: "CRC-8/SMBUS", Width: 8, Polynomial: 0x07, Init: 0x00, ReflectIn: false, ReflectOut: false, FinalXor: 0x00, Check: 0xF4, Residue: 0x00}
+	// CRC8TECH3250 is CRC-8/TECH-3250, also known as CRC-8/AES, CRC-8/EBU
+	CRC8TECH3250 = &Parameters{Name: "CRC-8/TECH-3250", Width: 8, Polynomial: 0x1D, Init: 0xFF, ReflectIn: true, ReflectOut: true, FinalXor: 0x00, Check: 0x97, Residue: 0x00}
+	// CRC8WCDMA is CRC-8/WCDMA
+	CRC8WCDMA = &Parameters{Name: "CRC-8/WCDMA", Width: 8, Polynomial: 0x9B, Init: 0x00, ReflectIn: true, ReflectOut: true, FinalXor: 0x00, Check: 0x25, Residue: 0x00}
+	// CRC10ATM is CRC-10/ATM, also known as CRC-10, CRC-10/I-610
+	CRC10ATM = &Parameters{Name: "CRC-10/ATM", Width: 10, Polynomial: 0x233, Init: 0x000, ReflectIn: false, ReflectOut: false, FinalXor: 0x000, Check: 0x199, Residue: 0x000}
+	// CRC10CDMA2000 is CRC-10/CDMA2000
+	CRC10CDMA2000 = &Parameters{Name: "CRC-10/CDMA2000", Width: 10, Polynomial: 0x3D9, Init: 0x3FF, ReflectIn: false, ReflectOut: false, FinalXor: 0x000, Check: 0x233, Residue: 0x000}
+	// CRC10GSM is CRC-10/GSM
+	CRC10GSM = &Parameters{Name: "CRC-10/GSM", Width: 10, Polynomial: 0x175, Init: 0x000, ReflectIn: false, ReflectOut: false, FinalXor: 0x3FF, Check: 0x12A, Residue: 0x0C6}
+	// CRC11FLEXRAY is CRC-11/FLEXRAY, also known as CRC-11
+	CRC11FLEXRAY = &Parameters{Name: "CRC-11/FLEXRAY", Width: 11, Polynomial: 0x385, Init: 0x01A, ReflectIn: false, ReflectOut: false, FinalXor: 0x000, Check: 0x5A3, Residue: 0x000}
+	// CRC11UMTS is CRC-11/UMTS
+	CRC11UMTS = &Parameters{Name: "CRC-11/UMTS", Width: 11, Polynomial: 0x307, Init: 0x000, ReflectIn: false, ReflectOut: false, FinalXor: 0x000, Check: 0x061, Residue: 0x000}
+	// CRC12CDMA2000 is CRC-12/CDMA2000
+	CRC12CDMA2000 = &Parameters{Name: "CRC-12/CDMA2000", Width: 12, Polynomial: 0xF13, Init: 0xFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0x000, Check: 0xD4D, Residue: 0x000}
+	// CRC12DECT is CRC-12/DECT, also known as X-CRC-12
+	CRC12DECT = &Parameters{Name: "CRC-12/DECT", Width: 12, Polynomial: 0x80F, Init: 0x000, ReflectIn: false, ReflectOut: false, FinalXor: 0x000, Check: 0xF5B, Residue: 0x000}
+	// CRC12GSM is CRC-12/GSM
+	CRC12GSM = &Parameters{Name: "CRC-12/GSM", Width: 12, Polynomial: 0xD31, Init: 0x000, ReflectIn: false, ReflectOut: false, FinalXor: 0xFFF, Check: 0xB34, Residue: 0x178}
+	// CRC12UMTS is CRC-12/UMTS, also known as CRC-12/3GPP
+	CRC12UMTS = &Parameters{Name: "CRC-12/UMTS", Width: 12, Polynomial: 0x80F, Init: 0x000, ReflectIn: false, ReflectOut: true, FinalXor: 0x000, Check: 0xDAF, Residue: 0x000}
+	// CRC13BBC is CRC-13/BBC
+	CRC13BBC = &Parameters{Name: "CRC-13/BBC", Width: 13, Polynomial: 0x1CF5, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000, Check: 0x04FA, Residue: 0x0000}
+	// CRC14DARC is CRC-14/DARC
+	CRC14DARC = &Parameters{Name: "CRC-14/DARC", Width: 14, Polynomial: 0x0805, Init: 0x0000, ReflectIn: true, ReflectOut: true, FinalXor: 0x0000, Check: 0x082D, Residue: 0x0000}
+	// CRC14GSM is CRC-14/GSM
+	CRC14GSM = &Parameters{Name: "CRC-14/GSM", Width: 14, Polynomial: 0x202D, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0x3FFF, Check: 0x30AE, Residue: 0x031E}
+	// CRC15CAN is CRC-15/CAN, also known as CRC-15
+	CRC15CAN = &Parameters{Name: "CRC-15/CAN", Width: 15, Polynomial: 0x4599, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000, Check: 0x059E, Residue: 0x0000}
+	// CRC15MPT1327 is CRC-15/MPT1327
+	CRC15MPT1327 = &Parameters{Name: "CRC-15/MPT1327", Width: 15, Polynomial: 0x6815, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0x0001, Check: 0x2566, Residue: 0x6815}
+	// CRC16ARC is CRC-16/ARC, also known as ARC, CRC-16, CRC-16/LHA, CRC-IBM
+	CRC16ARC = CRC16
+	// CRC16CDMA2000 is CRC-16/CDMA2000
+	CRC16CDMA2000 = &Parameters{Name: "CRC-16/CDMA2000", Width: 16, Polynomial: 0xC867, Init: 0xFFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000, Check: 0x4C06, Residue: 0x0000}
+	// CRC16CMS is CRC-16/CMS
+	CRC16CMS = &Parameters{Name: "CRC-16/CMS", Width: 16, Polynomial: 0x8005, Init: 0xFFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000, Check: 0xAEE7, Residue: 0x0000}
+	// CRC16DDS110 is CRC-16/DDS-110
+	CRC16DDS110 = &Parameters{Name: "CRC-16/DDS-110", Width: 16, Polynomial: 0x8005, Init: 0x800D, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000, Check: 0x9ECF, Residue: 0x0000}
+	// CRC16DECTR is CRC-16/DECT-R, also known as R-CRC-16
+	CRC16DECTR = &Parameters{Name: "CRC-16/DECT-R", Width: 16, Polynomial: 0x0589, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0x0001, Check: 0x007E, Residue: 0x0589}
+	// CRC16DECTX is CRC-16/DECT-X, also known as X-CRC-16
+	CRC16DECTX = &Parameters{Name: "CRC-16/DECT-X", Width: 16, Polynomial: 0x0589, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000, Check: 0x007F, Residue: 0x0000}
+	// CRC16DNP is CRC-16/DNP
+	CRC16DNP = &Parameters{Name: "CRC-16/DNP", Width: 16, Polynomial: 0x3D65, Init: 0x0000, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFF, Check: 0xEA82, Residue: 0x66C5}
+	// CRC16EN13757 is CRC-16/EN-13757
+	CRC16EN13757 = &Parameters{Name: "CRC-16/EN-13757", Width: 16, Polynomial: 0x3D65, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0xFFFF, Check: 0xC2B7, Residue: 0xA366}
+	// CRC16GENIBUS is CRC-16/GENIBUS, also known as CRC-16/DARC, CRC-16/EPC, CRC-16/EPC-C1G2, CRC-16/I-CODE
+	CRC16GENIBUS = &Parameters{Name: "CRC-16/GENIBUS", Width: 16, Polynomial: 0x1021, Init: 0xFFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0xFFFF, Check: 0xD64E, Residue: 0x1D0F}
+	// CRC16GSM is CRC-16/GSM
+	CRC16GSM = &Parameters{Name: "CRC-16/GSM", Width: 16, Polynomial: 0x1021, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0xFFFF, Check: 0xCE3C, Residue: 0x1D0F}
+	// CRC16IBM3740 is CRC-16/IBM-3740, also known as CRC-16/AUTOSAR, CRC-16/CCITT-FALSE
+	CRC16IBM3740 = CCITT
+	// CRC16IBMSDLC is CRC-16/IBM-SDLC, also known as CRC-16/ISO-HDLC, CRC-16/ISO-IEC-14443-3-B, CRC-16/X-25, CRC-B, X-25
+	CRC16IBMSDLC = X25
+	// CRC16ISOIEC144433A is CRC-16/ISO-IEC-14443-3-A, also known as CRC-A
+	CRC16ISOIEC144433A = &Parameters{Name: "CRC-16/ISO-IEC-14443-3-A", Width: 16, Polynomial: 0x1021, Init: 0xC6C6, ReflectIn: true, ReflectOut: true, FinalXor: 0x0000, Check: 0xBF05, Residue: 0x0000}
+	// CRC16KERMIT is CRC-16/KERMIT, also known as CRC-16/BLUETOOTH, CRC-16/CCITT, CRC-16/CCITT-TRUE, CRC-16/V-41-LSB, CRC-CCITT, KERMIT
+	CRC16KERMIT = &Parameters{Name: "CRC-16/KERMIT", Width: 16, Polynomial: 0x1021, Init: 0x0000, ReflectIn: true, ReflectOut: true, FinalXor: 0x0000, Check: 0x2189, Residue: 0x0000}
+	// CRC16LJ1200 is CRC-16/LJ1200
+	CRC16LJ1200 = &Parameters{Name: "CRC-16/LJ1200", Width: 16, Polynomial: 0x6F63, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000, Check: 0xBDF4, Residue: 0x0000}
+	// CRC16M17 is CRC-16/M17
+	CRC16M17 = &Parameters{Name: "CRC-16/M17", Width: 16, Polynomial: 0x5935, Init: 0xFFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000, Check: 0x772B, Residue: 0x0000}
+	// CRC16MAXIMDOW is CRC-16/MAXIM-DOW, also known as CRC-16/MAXIM
+	CRC16MAXIMDOW = &Parameters{Name: "CRC-16/MAXIM-DOW", Width: 16, Polynomial: 0x8005, Init: 0x0000, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFF, Check: 0x44C2, Residue: 0xB001}
+	// CRC16MCRF4XX is CRC-16/MCRF4XX
+	CRC16MCRF4XX = &Parameters{Name: "CRC-16/MCRF4XX", Width: 16, Polynomial: 0x1021, Init: 0xFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0x0000, Check: 0x6F91, Residue: 0x0000}
+	// CRC16MODBUS is CRC-16/MODBUS, also known as MODBUS
+	CRC16MODBUS = &Parameters{Name: "CRC-16/MODBUS", Width: 16, Polynomial: 0x8005, Init: 0xFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0x0000, Check: 0x4B37, Residue: 0x0000}
+	// CRC16NRSC5 is CRC-16/NRSC-5
+	CRC16NRSC5 = &Parameters{Name: "CRC-16/NRSC-5", Width: 16, Polynomial: 0x080B, Init: 0xFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0x0000, Check: 0xA066, Residue: 0x0000}
+	// CRC16OPENSAFETYA is CRC-16/OPENSAFETY-A
+	CRC16OPENSAFETYA = &Parameters{Name: "CRC-16/OPENSAFETY-A", Width: 16, Polynomial: 0x5935, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000, Check: 0x5D38, Residue: 0x0000}
+	// CRC16OPENSAFETYB is CRC-16/OPENSAFETY-B
+	CRC16OPENSAFETYB = &Parameters{Name: "CRC-16/OPENSAFETY-B", Width: 16, Polynomial: 0x755B, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000, Check: 0x20FE, Residue: 0x0000}
+	// CRC16PROFIBUS is CRC-16/PROFIBUS, also known as CRC-16/IEC-61158-2
+	CRC16PROFIBUS = &Parameters{Name: "CRC-16/PROFIBUS", Width: 16, Polynomial: 0x1DCF, Init: 0xFFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0xFFFF, Check: 0xA819, Residue: 0xE394}
+	// CRC16RIELLO is CRC-16/RIELLO
+	CRC16RIELLO = &Parameters{Name: "CRC-16/RIELLO", Width: 16, Polynomial: 0x1021, Init: 0xB2AA, ReflectIn: true, ReflectOut: true, FinalXor: 0x0000, Check: 0x63D0, Residue: 0x0000}
+	// CRC16SPIFUJITSU is CRC-16/SPI-FUJITSU, also known as CRC-16/AUG-CCITT
+	CRC16SPIFUJITSU = &Parameters{Name: "CRC-16/SPI-FUJITSU", Width: 16, Polynomial: 0x1021, Init: 0x1D0F, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000, Check: 0xE5CC, Residue: 0x0000}
+	// CRC16T10DIF is CRC-16/T10-DIF
+	CRC16T10DIF = &Parameters{Name: "CRC-16/T10-DIF", Width: 16, Polynomial: 0x8BB7, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000, Check: 0xD0DB, Residue: 0x0000}
+	// CRC16TELEDISK is CRC-16/TELEDISK
+	CRC16TELEDISK = &Parameters{Name: "CRC-16/TELEDISK", Width: 16, Polynomial: 0xA097, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000, Check: 0x0FB3, Residue: 0x0000}
+	// CRC16TMS37157 is CRC-16/TMS37157
+	CRC16TMS37157 = &Parameters{Name: "CRC-16/TMS37157", Width: 16, Polynomial: 0x1021, Init: 0x89EC, ReflectIn: true, ReflectOut: true, FinalXor: 0x0000, Check: 0x26B1, Residue: 0x0000}
+	// CRC16UMTS is CRC-16/UMTS, also known as CRC-16/BUYPASS, CRC-16/VERIFONE
+	CRC16UMTS = &Parameters{Name: "CRC-16/UMTS", Width: 16, Polynomial: 0x8005, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000, Check: 0xFEE8, Residue: 0x0000}
+	// CRC16USB is CRC-16/USB
+	CRC16USB = &Parameters{Name: "CRC-16/USB", Width: 16, Polynomial: 0x8005, Init: 0xFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFF, Check: 0xB4C8, Residue: 0xB001}
+	// CRC16XMODEM is CRC-16/XMODEM, also known as CRC-16/ACORN, CRC-16/LTE, CRC-16/V-41-MSB, XMODEM, ZMODEM
+	CRC16XMODEM = XMODEM
+	// CRC17CANFD is CRC-17/CAN-FD
+	CRC17CANFD = &Parameters{Name: "CRC-17/CAN-FD", Width: 17, Polynomial: 0x1685B, Init: 0x00000, ReflectIn: false, ReflectOut: false, FinalXor: 0x00000, Check: 0x04F03, Residue: 0x00000}
+	// CRC21CANFD is CRC-21/CAN-FD
+	CRC21CANFD = &Parameters{Name: "CRC-21/CAN-FD", Width: 21, Polynomial: 0x102899, Init: 0x000000, ReflectIn: false, ReflectOut: false, FinalXor: 0x000000, Check: 0x0ED841, Residue: 0x000000}
+	// CRC24BLE is CRC-24/BLE
+	CRC24BLE = &Parameters{Name: "CRC-24/BLE", Width: 24, Polynomial: 0x00065B, Init: 0x555555, ReflectIn: true, ReflectOut: true, FinalXor: 0x000000, Check: 0xC25A56, Residue: 0x000000}
+	// CRC24FLEXRAYA is CRC-24/FLEXRAY-A
+	CRC24FLEXRAYA = &Parameters{Name: "CRC-24/FLEXRAY-A", Width: 24, Polynomial: 0x5D6DCB, Init: 0xFEDCBA, ReflectIn: false, ReflectOut: false, FinalXor: 0x000000, Check: 0x7979BD, Residue: 0x000000}
+	// CRC24FLEXRAYB is CRC-24/FLEXRAY-B
+	CRC24FLEXRAYB = &Parameters{Name: "CRC-24/FLEXRAY-B", Width: 24, Polynomial: 0x5D6DCB, Init: 0xABCDEF, ReflectIn: false, ReflectOut: false, FinalXor: 0x000000, Check: 0x1F23B8, Residue: 0x000000}
+	// CRC24INTERLAKEN is CRC-24/INTERLAKEN
+	CRC24INTERLAKEN = &Parameters{Name: "CRC-24/INTERLAKEN", Width: 24, Polynomial: 0x328B63, Init: 0xFFFFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0xFFFFFF, Check: 0xB4F3E6, Residue: 0x144E63}
+	// CRC24LTEA is CRC-24/LTE-A
+	CRC24LTEA = &Parameters{Name: "CRC-24/LTE-A", Width: 24, Polynomial: 0x864CFB, Init: 0x000000, ReflectIn: false, ReflectOut: false, FinalXor: 0x000000, Check: 0xCDE703, Residue: 0x000000}
+	// CRC24LTEB is CRC-24/LTE-B
+	CRC24LTEB = &Parameters{Name: "CRC-24/LTE-B", Width: 24, Polynomial: 0x800063, Init: 0x000000, ReflectIn: false, ReflectOut: false, FinalXor: 0x000000, Check: 0x23EF52, Residue: 0x000000}
+	// CRC24OPENPGP is CRC-24/OPENPGP, also known as CRC-24
+	CRC24OPENPGP = &Parameters{Name: "CRC-24/OPENPGP", Width: 24, Polynomial: 0x864CFB, Init: 0xB704CE, ReflectIn: false, ReflectOut: false, FinalXor: 0x000000, Check: 0x21CF02, Residue: 0x000000}
+	// CRC24OS9 is CRC-24/OS-9
+	CRC24OS9 = &Parameters{Name: "CRC-24/OS-9", Width: 24, Polynomial: 0x800063, Init: 0xFFFFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0xFFFFFF, Check: 0x200FA5, Residue: 0x800FE3}
+	// CRC30CDMA is CRC-30/CDMA
+	CRC30CDMA = &Parameters{Name: "CRC-30/CDMA", Width: 30, Polynomial: 0x2030B9C7, Init: 0x3FFFFFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0x3FFFFFFF, Check: 0x04C34ABF, Residue: 0x34EFA55A}
+	// CRC31PHILIPS is CRC-31/PHILIPS
+	CRC31PHILIPS = &Parameters{Name: "CRC-31/PHILIPS", Width: 31, Polynomial: 0x04C11DB7, Init: 0x7FFFFFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0x7FFFFFFF, Check: 0x0CE9E46C, Residue: 0x4EAF26F1}
+	// CRC32AIXM is CRC-32/AIXM, also known as CRC-32Q
+	CRC32AIXM = &Parameters{Name: "CRC-32/AIXM", Width: 32, Polynomial: 0x814141AB, Init: 0x00000000, ReflectIn: false, ReflectOut: false, FinalXor: 0x00000000, Check: 0x3010BF7F, Residue: 0x00000000}
+	// CRC32AUTOSAR is CRC-32/AUTOSAR
+	CRC32AUTOSAR = &Parameters{Name: "CRC-32/AUTOSAR", Width: 32, Polynomial: 0xF4ACFB13, Init: 0xFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFFFFFF, Check: 0x1697D06A, Residue: 0x904CDDBF}
+	// CRC32BASE91D is CRC-32/BASE91-D, also known as CRC-32D
+	CRC32BASE91D = &Parameters{Name: "CRC-32/BASE91-D", Width: 32, Polynomial: 0xA833982B, Init: 0xFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFFFFFF, Check: 0x87315576, Residue: 0x45270551}
+	// CRC32BZIP2 is CRC-32/BZIP2, also known as CRC-32/AAL5, CRC-32/DECT-B, B-CRC-32
+	CRC32BZIP2 = &Parameters{Name: "CRC-32/BZIP2", Width: 32, Polynomial: 0x04C11DB7, Init: 0xFFFFFFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0xFFFFFFFF, Check: 0xFC891918, Residue: 0xC704DD7B}
+	// CRC32CDROMEDC is CRC-32/CD-ROM-EDC
+	CRC32CDROMEDC = &Parameters{Name: "CRC-32/CD-ROM-EDC", Width: 32, Polynomial: 0x8001801B, Init: 0x00000000, ReflectIn: true, ReflectOut: true, FinalXor: 0x00000000, Check: 0x6EC2EDC4, Residue: 0x00000000}
+	// CRC32CKSUM is CRC-32/CKSUM, also known as CKSUM, CRC-32/POSIX
+	CRC32CKSUM = &Parameters{Name: "CRC-32/CKSUM", Width: 32, Polynomial: 0x04C11DB7, Init: 0x00000000, ReflectIn: false, ReflectOut: false, FinalXor: 0xFFFFFFFF, Check: 0x765E7680, Residue: 0xC704DD7B}
+	// CRC32ISCSI is CRC-32/ISCSI, also known as CRC-32/BASE91-C, CRC-32/CASTAGNOLI, CRC-32/INTERLAKEN, CRC-32C, CRC-32/NVME
+	CRC32ISCSI = Castagnoli
+	// CRC32ISOHDLC is CRC-32/ISO-HDLC, also known as CRC-32, CRC-32/ADCCP, CRC-32/V-42, CRC-32/XZ, PKZIP
+	CRC32ISOHDLC = CRC32
+	// CRC32JAMCRC is CRC-32/JAMCRC, also known as JAMCRC
+	CRC32JAMCRC = &Parameters{Name: "CRC-32/JAMCRC", Width: 32, Polynomial: 0x04C11DB7, Init: 0xFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0x00000000, Check: 0x340BC6D9, Residue: 0x00000000}
+	// CRC32MEF is CRC-32/MEF
+	CRC32MEF = &Parameters{Name: "CRC-32/MEF", Width: 32, Polynomial: 0x741B8CD7, Init: 0xFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0x00000000, Check: 0xD2C22F51, Residue: 0x00000000}
+	// CRC32MPEG2 is CRC-32/MPEG-2
+	CRC32MPEG2 = &Parameters{Name: "CRC-32/MPEG-2", Width: 32, Polynomial: 0x04C11DB7, Init: 0xFFFFFFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0x00000000, Check: 0x0376E6E7, Residue: 0x00000000}
+	// CRC32XFER is CRC-32/XFER, also known as XFER
+	CRC32XFER = &Parameters{Name: "CRC-32/XFER", Width: 32, Polynomial: 0x000000AF, Init: 0x00000000, ReflectIn: false, ReflectOut: false, FinalXor: 0x00000000, Check: 0xBD0BE338, Residue: 0x00000000}
+	// CRC40GSM is CRC-40/GSM
+	CRC40GSM = &Parameters{Name: "CRC-40/GSM", Width: 40, Polynomial: 0x0004820009, Init: 0x0000000000, ReflectIn: false, ReflectOut: false, FinalXor: 0xFFFFFFFFFF, Check: 0xD4164FC646, Residue: 0xC4FF8071FF}
+	// CRC64ECMA182 is CRC-64/ECMA-182, also known as CRC-64
+	CRC64ECMA182 = &Parameters{Name: "CRC-64/ECMA-182", Width: 64, Polynomial: 0x42F0E1EBA9EA3693, Init: 0x0000000000000000, ReflectIn: false, ReflectOut: false, FinalXor: 0x0000000000000000, Check: 0x6C40DF5F0B497347, Residue: 0x0000000000000000}
+	// CRC64GOISO is CRC-64/GO-ISO
+	CRC64GOISO = CRC64ISO
+	// CRC64MS is CRC-64/MS
+	CRC64MS = &Parameters{Name: "CRC-64/MS", Width: 64, Polynomial: 0x259C84CBA6426349, Init: 0xFFFFFFFFFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0x0000000000000000, Check: 0x75D4B74F024ECEEA, Residue: 0x0000000000000000}
+	// CRC64NVME is CRC-64/NVME
+	CRC64NVME = &Parameters{Name: "CRC-64/NVME", Width: 64, Polynomial: 0xAD93D23594C93659, Init: 0xFFFFFFFFFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFFFFFFFFFFFFFF, Check: 0xAE8B14860A799888, Residue: 0xF310303B2B6F6E42}
+	// CRC64REDIS is CRC-64/REDIS
+	CRC64REDIS = &Parameters{Name: "CRC-64/REDIS", Width: 64, Polynomial: 0xAD93D23594C935A9, Init: 0x0000000000000000, ReflectIn: true, ReflectOut: true, FinalXor: 0x0000000000000000, Check: 0xE9C6D914C4B8D9CA, Residue: 0x0000000000000000}
+	// CRC64WE is CRC-64/WE
+	CRC64WE = &Parameters{Name: "CRC-64/WE", Width: 64, Polynomial: 0x42F0E1EBA9EA3693, Init: 0xFFFFFFFFFFFFFFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0xFFFFFFFFFFFFFFFF, Check: 0x62EC59E3F1A4F00A, Residue: 0xFCACBEBD5931A992}
+	// CRC64XZ is CRC-64/XZ, also known as CRC-64/GO-ECMA
+	CRC64XZ = CRC64ECMA
+)
+
+// catalogue lists every named parameter set known to this package along with its aliases.
+var catalogue = []struct {
+	params  *Parameters
+	aliases []string
+}{
+	{CRC3GSM, nil},
+	{CRC3ROHC, nil},
+	{CRC4G704, []string{"CRC-4/ITU"}},
+	{CRC4INTERLAKEN, nil},
+	{CRC5EPCC1G2, []string{"CRC-5/EPC"}},
+	{CRC5G704, []string{"CRC-5/ITU"}},
+	{CRC5USB, nil},
+	{CRC6CDMA2000A, nil},
+	{CRC6CDMA2000B, nil},
+	{CRC6DARC, nil},
+	{CRC6G704, []string{"CRC-6/ITU"}},
+	{CRC6GSM, nil},
+	{CRC7MMC, []string{"CRC-7"}},
+	{CRC7ROHC, nil},
+	{CRC7UMTS, nil},
+	{CRC8AUTOSAR, nil},
+	{CRC8BLUETOOTH, nil},
+	{CRC8CDMA2000, nil},
+	{CRC8DARC, nil},
+	{CRC8DVBS2, nil},
+	{CRC8GSMA, nil},
+	{CRC8GSMB, nil},
+	{CRC8HITAG, nil},
+	{CRC8I4321, []string{"CRC-8/ITU"}},
+	{CRC8ICODE, nil},
+	{CRC8LTE, nil},
+	{CRC8MAXIMDOW, []string{"CRC-8/MAXIM", "DOW-CRC"}},
+	{CRC8MIFAREMAD, nil},
+	{CRC8NRSC5, nil},
+	{CRC8OPENSAFETY, nil},
+	{CRC8ROHC, nil},
+	{CRC8SAEJ1850, nil},
+	{CRC8SMBUS, []string{"CRC-8"}},
+	{CRC8TECH3250, []string{"CRC-8/AES", "CRC-8/EBU"}},
+	{CRC8WCDMA, nil},
+	{CRC10ATM, []string{"CRC-10", "CRC-10/I-610"}},
+	{CRC10CDMA2000, nil},
+	{CRC10GSM, nil},
+	{CRC11FLEXRAY, []string{"CRC-11"}},
+	{CRC11UMTS, nil},
+	{CRC12CDMA2000, nil},
+	{CRC12DECT, []string{"X-CRC-12"}},
+	{CRC12GSM, nil},
+	{CRC12UMTS, []string{"CRC-12/3GPP"}},
+	{CRC13BBC, nil},
+	{CRC14DARC, nil},
+	{CRC14GSM, nil},
+	{CRC15CAN, []string{"CRC-15"}},
+	{CRC15MPT1327, nil},
+	{CRC16ARC, []string{"ARC", "CRC-16", "CRC-16/LHA", "CRC-IBM"}},
+	{CRC16CDMA2000, nil},
+	{CRC16CMS, nil},
+	{CRC16DDS110, nil},
+	{CRC16DECTR, []string{"R-CRC-16"}},
+	{CRC16DECTX, []string{"X-CRC-16"}},
+	{CRC16DNP, nil},
+	{CRC16EN13757, nil},
+	{CRC16GENIBUS, []string{"CRC-16/DARC", "CRC-16/EPC", "CRC-16/EPC-C1G2", "CRC-16/I-CODE"}},
+	{CRC16GSM, nil},
+	{CRC16IBM3740, []string{"CRC-16/AUTOSAR", "CRC-16/CCITT-FALSE"}},
+	{CRC16IBMSDLC, []string{"CRC-16/ISO-HDLC", "CRC-16/ISO-IEC-14443-3-B", "CRC-16/X-25", "CRC-B", "X-25"}},
+	{CRC16ISOIEC144433A, []string{"CRC-A"}},
+	{CRC16KERMIT, []string{"CRC-16/BLUETOOTH", "CRC-16/CCITT", "CRC-16/CCITT-TRUE", "CRC-16/V-41-LSB", "CRC-CCITT", "KERMIT"}},
+	{CRC16LJ1200, nil},
+	{CRC16M17, nil},
+	{CRC16MAXIMDOW, []string{"CRC-16/MAXIM"}},
+	{CRC16MCRF4XX, nil},
+	{CRC16MODBUS, []string{"MODBUS"}},
+	{CRC16NRSC5, nil},
+	{CRC16OPENSAFETYA, nil},
+	{CRC16OPENSAFETYB, nil},
+	{CRC16PROFIBUS, []string{"CRC-16/IEC-61158-2"}},
+	{CRC16RIELLO, nil},
+	{CRC16SPIFUJITSU, []string{"CRC-16/AUG-CCITT"}},
+	{CRC16T10DIF, nil},
+	{CRC16TELEDISK, nil},
+	{CRC16TMS37157, nil},
+	{CRC16UMTS, []string{"CRC-16/BUYPASS", "CRC-16/VERIFONE"}},
+	{CRC16USB, nil},
+	{CRC16XMODEM, []string{"CRC-16/ACORN", "CRC-16/LTE", "CRC-16/V-41-MSB", "XMODEM", "ZMODEM"}},
+	{CRC17CANFD, nil},
+	{CRC21CANFD, nil},
+	{CRC24BLE, nil},
+	{CRC24FLEXRAYA, nil},
+	{CRC24FLEXRAYB, nil},
+	{CRC24INTERLAKEN, nil},
+	{CRC24LTEA, nil},
+	{CRC24LTEB, nil},
+	{CRC24OPENPGP, []string{"CRC-24"}},
+	{CRC24OS9, nil},
+	{CRC30CDMA, nil},
+	{CRC31PHILIPS, nil},
+	{CRC32AIXM, []string{"CRC-32Q"}},
+	{CRC32AUTOSAR, nil},
+	{CRC32BASE91D, []string{"CRC-32D"}},
+	{CRC32BZIP2, []string{"CRC-32/AAL5", "CRC-32/DECT-B", "B-CRC-32"}},
+	{CRC32CDROMEDC, nil},
+	{CRC32CKSUM, []string{"CKSUM", "CRC-32/POSIX"}},
+	{CRC32ISCSI, []string{"CRC-32/BASE91-C", "CRC-32/CASTAGNOLI", "CRC-32/INTERLAKEN", "CRC-32C", "CRC-32/NVME"}},
+	{CRC32ISOHDLC, []string{"CRC-32", "CRC-32/ADCCP", "CRC-32/V-42", "CRC-32/XZ", "PKZIP"}},
+	{CRC32JAMCRC, []string{"JAMCRC"}},
+	{CRC32MEF, nil},
+	{CRC32MPEG2, nil},
+	{CRC32XFER, []string{"XFER"}},
+	{CRC40GSM, nil},
+	{CRC64ECMA182, []string{"CRC-64"}},
+	{CRC64GOISO, nil},
+	{CRC64MS, nil},
+	{CRC64NVME, nil},
+	{CRC64REDIS, nil},
+	{CRC64WE, nil},
+	{CRC64XZ, []string{"CRC-64/GO-ECMA"}},
+}
+
+// catalogueIndex maps normalized names and aliases to parameter sets
+var catalogueIndex = buildCatalogueIndex()
+
+func buildCatalogueIndex() map[string]*Parameters {
+	index := make(map[string]*Parameters, 2*len(catalogue))
+	for _, entry := range catalogue {
+		index[normalizeName(entry.params.Name)] = entry.params
+		for _, alias := range entry.aliases {
+			index[normalizeName(alias)] = entry.params
+		}
+	}
+	return index
+}
+
+// normalizeName lower cases name and drops everything but letters and digits from it,
+// so that "CRC-16/MODBUS", "crc16modbus" and "Crc 16 Modbus" are all considered the same.
+func normalizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		}
+		return -1
+	}, name)
+}
+
+// Lookup finds a parameter set by its name as listed in CRC RevEng catalogue, or any of its aliases.
+// Matching ignores case and punctuation, so "CRC-16/MODBUS", "crc16modbus" and "MODBUS" all
+// resolve to CRC16MODBUS. Returned Parameters are shared, so do not modify them.
+func Lookup(name string) (*Parameters, bool) {
+	params, ok := catalogueIndex[normalizeName(name)]
+	return params, ok
+}
@@ -0,0 +1,50 @@
+package crc
+
+import "testing"
+
+func TestCatalogueSelfTest(t *testing.T) {
+	for _, entry := range catalogue {
+		if err := entry.params.SelfTest(); err != nil {
+			t.Errorf("Self test failed for %s: %v", entry.params.Name, err)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	doTest := func(name string, expected *Parameters) {
+		params, ok := Lookup(name)
+		if !ok {
+			t.Errorf("Lookup(%q) found nothing when should find %s", name, expected.Name)
+		} else if params != expected {
+			t.Errorf("Lookup(%q) found %s when should find %s", name, params.Name, expected.Name)
+		}
+	}
+
+	doTest("CRC-16/MODBUS", CRC16MODBUS)
+	doTest("crc-16/modbus", CRC16MODBUS)
+	doTest("MODBUS", CRC16MODBUS)
+	doTest("crc16modbus", CRC16MODBUS)
+	doTest("CRC-3/GSM", CRC3GSM)
+	doTest("CRC-64/XZ", CRC64ECMA)
+	doTest("CRC-64/GO-ECMA", CRC64ECMA)
+	doTest("crc32", CRC32)
+	doTest("CRC-32C", Castagnoli)
+	doTest("CRC-16/CCITT-FALSE", CCITT)
+	doTest("X-25", X25)
+	doTest("CRC-8", CRC8SMBUS)
+	doTest("CRC-16", CRC16)
+
+	for _, name := range []string{"", "CRC-16/UNKNOWN", "CRC-65"} {
+		if params, ok := Lookup(name); ok {
+			t.Errorf("Lookup(%q) found %s when should find nothing", name, params.Name)
+		}
+	}
+
+	// every name and alias must resolve to its own entry, i.e. no two entries collide once normalized
+	for _, entry := range catalogue {
+		doTest(entry.params.Name, entry.params)
+		for _, alias := range entry.aliases {
+			doTest(alias, entry.params)
+		}
+	}
+}
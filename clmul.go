@@ -0,0 +1,20 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+// Carry-less multiplication folding is used on CPUs providing such instruction (see clmul_amd64.go).
+//
+// Input is split into 128-bit blocks B[0], B[1], ... and the data processed so far is kept folded
+// into a single 128-bit value F = H*x^64 + L, which is congruent to the data modulo CRC polynomial P.
+// Appending next block then only takes two 64x64 bit carry-less multiplications:
+//
+//	F' = F*x^128 + B = H*(x^192 mod P) + L*(x^128 mod P) + B   (mod P)
+//
+// Constants depend on P only, so they are calculated once by NewTable, which makes folding work for
+// any Parameters. Once all blocks are folded, CRC of the data is CRC of the final 16 bytes of F,
+// which is finished using the regular table. Initial register value is xored into the first block.
+
+// clmulThreshold is the smallest number of bytes UpdateCrc processes with carry-less multiplication folding
+const clmulThreshold = 256
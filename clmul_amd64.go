@@ -0,0 +1,70 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64 && !purego
+
+package crc
+
+import "encoding/binary"
+
+// hasCLMUL reports whether CPU supports PCLMULQDQ and PSHUFB (SSSE3) instructions.
+// Implemented in clmul_amd64.s
+func hasCLMUL() bool
+
+// foldCLMUL folds p into 128-bit value (hi:lo) and returns the result. Length of p must be
+// a multiple of 16 and at least 16. k holds folding constants as prepared by initCLMUL.
+// If swap is true, bytes of every block are reversed, so that the first byte becomes the most significant one.
+// Implemented in clmul_amd64.s
+//
+//go:noescape
+func foldCLMUL(lo, hi uint64, p []byte, k *[4]uint64, swap bool) (uint64, uint64)
+
+var useCLMUL = hasCLMUL()
+
+// initCLMUL calculates folding constants for the Table if CPU supports carry-less multiplication.
+//
+// For non reflected algorithms blocks are byte swapped, so high 64 bits of the register hold
+// higher degree coefficients. For reflected ones the register is bit reversed as a whole instead,
+// which swaps the halves and adds an extra x to every product, compensated by using x^(n-1).
+func (t *Table) initCLMUL() {
+	if !useCLMUL {
+		return
+	}
+	k := new([4]uint64)
+	width, poly := t.crcParams.Width, t.crcParams.Polynomial
+	if t.crcParams.ReflectIn {
+		for i, n := range []uint64{128 + 63, 128 - 1, 512 + 63, 512 - 1} {
+			k[i] = reflect(xPowMod(n, poly, width), 64)
+		}
+	} else {
+		for i, n := range []uint64{128, 128 + 64, 512, 512 + 64} {
+			k[i] = xPowMod(n, poly, width)
+		}
+	}
+	t.clmul = k
+}
+
+// updateCLMUL processes as many 16 byte blocks of p as possible using carry-less multiplication folding.
+// It returns updated CRC and the remaining part of p to be processed by other means.
+func (t *Table) updateCLMUL(curValue uint64, p []byte) (uint64, []byte) {
+	n := len(p) &^ 15
+	var lo, hi uint64
+	if t.crcParams.ReflectIn {
+		lo = curValue
+	} else {
+		hi = (curValue & t.mask) << (64 - t.crcParams.Width)
+	}
+
+	lo, hi = foldCLMUL(lo, hi, p[:n], t.clmul, !t.crcParams.ReflectIn)
+
+	var folded [16]byte
+	if t.crcParams.ReflectIn {
+		binary.LittleEndian.PutUint64(folded[:8], lo)
+		binary.LittleEndian.PutUint64(folded[8:], hi)
+	} else {
+		binary.BigEndian.PutUint64(folded[:8], hi)
+		binary.BigEndian.PutUint64(folded[8:], lo)
+	}
+	return t.updateBytewise(0, folded[:]), p[n:]
+}
@@ -0,0 +1,120 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build amd64 && !purego
+
+#include "textflag.h"
+
+// PSHUFB masks reversing order of bytes in a block, or keeping it as is
+DATA swapMask<>+0(SB)/8, $0x08090a0b0c0d0e0f
+DATA swapMask<>+8(SB)/8, $0x0001020304050607
+GLOBL swapMask<>(SB), RODATA, $16
+
+DATA keepMask<>+0(SB)/8, $0x0706050403020100
+DATA keepMask<>+8(SB)/8, $0x0f0e0d0c0b0a0908
+GLOBL keepMask<>(SB), RODATA, $16
+
+// func hasCLMUL() bool
+TEXT ·hasCLMUL(SB), NOSPLIT, $0-1
+	MOVL $1, AX
+	XORL CX, CX
+	CPUID
+	// PCLMULQDQ is bit 1 and SSSE3 is bit 9 of ECX
+	ANDL $0x202, CX
+	CMPL CX, $0x202
+	SETEQ ret+0(FP)
+	RET
+
+// FOLD folds 128-bit value in reg by multiplying its low half by low half of constants in k
+// and its high half by high half of k. tmp is clobbered.
+#define FOLD(reg, k, tmp) \
+	MOVO      reg, tmp;      \
+	PCLMULQDQ $0x00, k, reg; \
+	PCLMULQDQ $0x11, k, tmp; \
+	PXOR      tmp, reg
+
+// LOAD loads next block at offset off into reg, reversing its bytes if needed
+#define LOAD(off, reg) \
+	MOVOU  off(SI), reg; \
+	PSHUFB X3, reg
+
+// func foldCLMUL(lo, hi uint64, p []byte, k *[4]uint64, swap bool) (uint64, uint64)
+TEXT ·foldCLMUL(SB), NOSPLIT, $0-72
+	MOVQ lo+0(FP), X0
+	MOVQ hi+8(FP), X1
+	PUNPCKLQDQ X1, X0
+	MOVQ p_base+16(FP), SI
+	MOVQ p_len+24(FP), CX
+	MOVQ k+40(FP), AX
+	MOVOU 0(AX), X2      // constants folding over 128 bits
+	MOVOU 16(AX), X8     // constants folding over 512 bits
+
+	MOVOU keepMask<>(SB), X3
+	CMPB swap+48(FP), $0
+	JE   first
+	MOVOU swapMask<>(SB), X3
+
+first:
+	CMPQ CX, $64
+	JB   single
+
+	// fold four blocks in parallel as long as possible
+	LOAD(0, X1)
+	PXOR X1, X0
+	LOAD(16, X5)
+	LOAD(32, X6)
+	LOAD(48, X7)
+	ADDQ $64, SI
+	SUBQ $64, CX
+
+loop4:
+	CMPQ CX, $64
+	JB   reduce4
+	FOLD(X0, X8, X4)
+	LOAD(0, X1)
+	PXOR X1, X0
+	FOLD(X5, X8, X4)
+	LOAD(16, X1)
+	PXOR X1, X5
+	FOLD(X6, X8, X4)
+	LOAD(32, X1)
+	PXOR X1, X6
+	FOLD(X7, X8, X4)
+	LOAD(48, X1)
+	PXOR X1, X7
+	ADDQ $64, SI
+	SUBQ $64, CX
+	JMP  loop4
+
+reduce4:
+	// fold four parallel values into one
+	FOLD(X0, X2, X4)
+	PXOR X5, X0
+	FOLD(X0, X2, X4)
+	PXOR X6, X0
+	FOLD(X0, X2, X4)
+	PXOR X7, X0
+	JMP  loop1
+
+single:
+	LOAD(0, X1)
+	PXOR X1, X0
+	ADDQ $16, SI
+	SUBQ $16, CX
+
+loop1:
+	CMPQ CX, $16
+	JB   done
+	FOLD(X0, X2, X4)
+	LOAD(0, X1)
+	PXOR X1, X0
+	ADDQ $16, SI
+	SUBQ $16, CX
+	JMP  loop1
+
+done:
+	MOVQ   X0, ret+56(FP)
+	PSHUFD $0x4e, X0, X0
+	MOVQ   X0, ret1+64(FP)
+	RET
@@ -0,0 +1,15 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !amd64 || purego
+
+package crc
+
+// initCLMUL does nothing as carry-less multiplication is not supported on this platform
+func (t *Table) initCLMUL() {}
+
+// updateCLMUL is never called as initCLMUL never enables it on this platform
+func (t *Table) updateCLMUL(curValue uint64, p []byte) (uint64, []byte) {
+	return curValue, p
+}
@@ -0,0 +1,51 @@
+package crc
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestCLMUL(t *testing.T) {
+	if NewTable(CRC32).clmul == nil {
+		t.Skip("carry-less multiplication is not supported")
+	}
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 4096)
+	rnd.Read(data)
+
+	doTest := func(params *Parameters) {
+		table := NewTable(params)
+		for _, length := range []int{16, 17, 32, 48, 63, 64, 80, 127, 128, 255, 256, 1000, 4095, 4096} {
+			expected := table.CRC(table.updateBytewise(table.InitCrc(), data[:length]))
+			crc, rest := table.updateCLMUL(table.InitCrc(), data[:length])
+			if len(rest) != length%16 {
+				t.Errorf("Folding left %d bytes out of %d unprocessed", len(rest), length)
+			}
+			calculated := table.CRC(table.updateBytewise(crc, rest))
+			if calculated != expected {
+				t.Errorf("Incorrect CRC 0x%04x calculated by folding for %s over %d bytes (should be 0x%04x)", calculated, params.Name, length, expected)
+			}
+		}
+		// folding in the middle of a calculation
+		crc := table.updateBytewise(table.InitCrc(), data[:5])
+		crc = table.UpdateCrc(crc, data[5:3000])
+		crc = table.UpdateCrc(crc, data[3000:])
+		if calculated := table.CRC(crc); calculated != CalculateCRC(params, data) {
+			t.Errorf("Incorrect CRC 0x%04x calculated by mixing folding and bytewise for %s", calculated, params.Name)
+		}
+	}
+
+	for _, entry := range catalogue {
+		doTest(entry.params)
+	}
+	doTest(XMODEM2)
+	doTest(Koopman)
+}
+
+func BenchmarkUpdateCrcFolding(b *testing.B) {
+	for _, params := range []*Parameters{CRC64ECMA182, CRC64XZ, CRC32MPEG2, CRC32, CCITT, CRC8SMBUS} {
+		b.Run(params.Name, func(b *testing.B) {
+			benchmarkUpdate(b, params, (*Table).UpdateCrc)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+// Combine calculates CRC of concatenation of two blocks A and B, given only CRC of A, CRC of B and length of B
+// in bytes. Both CRCs must be final values (as returned by CRC or CalculateCRC) calculated with this Table.
+// It works for any Parameters, including non-zero Init and FinalXor, and takes O(log lenB) time.
+// Combine panics if lenB is negative.
+func (t *Table) Combine(crcA, crcB uint64, lenB int64) uint64 {
+	if lenB < 0 {
+		panic("crc: Combine called with negative length")
+	}
+	// Register after A||B is register after A advanced over len(B) zero bytes, plus register
+	// after B started from zero. The latter is register after B less Init advanced over len(B) zero bytes.
+	stateA := t.StateFromCRC(crcA)
+	stateB := t.StateFromCRC(crcB)
+	return t.CRC(t.shiftState(stateA^t.initValue, uint64(lenB)) ^ stateB)
+}
+
+// StateFromCRC reverts what CRC method does, i.e. returns the register value (as used by UpdateCrc)
+// which produces crc. Along with Unupdate, it recovers the register value at any point of a message
+// given its final CRC.
+func (t *Table) StateFromCRC(crc uint64) uint64 {
+	state := (crc ^ t.crcParams.FinalXor) & t.mask
+	if t.crcParams.ReflectOut != t.crcParams.ReflectIn {
+		state = reflect(state, t.crcParams.Width)
+	}
+	return state
+}
+
+// toNormal converts register value as used by this Table to normal (non reflected) polynomial form
+func (t *Table) toNormal(state uint64) uint64 {
+	state &= t.mask
+	if t.crcParams.ReflectIn {
+		state = reflect(state, t.crcParams.Width)
+	}
+	return state
+}
+
+// fromNormal converts polynomial in normal (non reflected) form to register value as used by this Table
+func (t *Table) fromNormal(value uint64) uint64 {
+	if t.crcParams.ReflectIn {
+		value = reflect(value, t.crcParams.Width)
+	}
+	return value
+}
+
+// shiftState returns register value after processing n zero bytes starting from state,
+// which is state*x^(8n) mod P.
+func (t *Table) shiftState(state uint64, n uint64) uint64 {
+	width, poly := t.crcParams.Width, t.crcParams.Polynomial
+	factor := powMod(xPowMod(8, poly, width), n, poly, width)
+	return t.fromNormal(mulMod(t.toNormal(state), factor, poly, width))
+}
@@ -0,0 +1,53 @@
+package crc
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestCombine(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 1000)
+	rnd.Read(data)
+
+	doTest := func(params *Parameters) {
+		table := NewTable(params)
+		expected := table.CalculateCRC(data)
+		for _, split := range []int{0, 1, 8, 100, 999, 1000} {
+			crcA := table.CalculateCRC(data[:split])
+			crcB := table.CalculateCRC(data[split:])
+			calculated := table.Combine(crcA, crcB, int64(len(data)-split))
+			if calculated != expected {
+				t.Errorf("Incorrect CRC 0x%04x combined for %s split at %d (should be 0x%04x)", calculated, params.Name, split, expected)
+			}
+		}
+	}
+
+	for _, entry := range catalogue {
+		doTest(entry.params)
+	}
+	doTest(Koopman)
+}
+
+func TestCombineLongZeroRun(t *testing.T) {
+	// combining with a large block of zeros must match calculation done the hard way
+	zeros := make([]byte, 1<<20)
+	for _, params := range []*Parameters{CRC32, CRC64ECMA182, CRC16MODBUS, CRC5USB} {
+		table := NewTable(params)
+		crcA := table.CalculateCRC([]byte("123456789"))
+		crcB := table.CalculateCRC(zeros)
+		expected := table.UpdateCrc(table.UpdateCrc(table.InitCrc(), []byte("123456789")), zeros)
+		if calculated := table.Combine(crcA, crcB, int64(len(zeros))); calculated != table.CRC(expected) {
+			t.Errorf("Incorrect CRC 0x%04x combined for %s (should be 0x%04x)", calculated, params.Name, table.CRC(expected))
+		}
+	}
+}
+
+func TestCombineNegativeLength(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Combine did not panic for negative length")
+		}
+	}()
+	NewTable(CRC32).Combine(0, 0, -1)
+}
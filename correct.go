@@ -0,0 +1,180 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import "errors"
+
+// Errors reported by Correct
+var (
+	// ErrFrameTooShort means the frame is too short to hold a CRC.
+	ErrFrameTooShort = errors.New("crc: frame too short")
+	// ErrFrameLength means the frame passed to Corrector is not as long as the Corrector was created for.
+	ErrFrameLength = errors.New("crc: frame length does not match Corrector")
+	// ErrUncorrectable means no error pattern of up to the allowed number of bits explains the CRC mismatch.
+	ErrUncorrectable = errors.New("crc: frame cannot be corrected")
+	// ErrAmbiguousCorrection means several error patterns of the same (smallest) number of bits explain
+	// the CRC mismatch, so it is not known which one has actually happened.
+	ErrAmbiguousCorrection = errors.New("crc: frame correction is ambiguous")
+)
+
+// syndromeTable holds syndromes of single bit errors in frames of a fixed length
+type syndromeTable struct {
+	syndromes []uint64         // syndrome of an error in each bit position
+	positions map[uint64][]int // bit positions by syndrome, several of them may share it in long frames
+}
+
+// Correct tries to correct bit errors in frame consisting of a message followed by its CRC.
+// CRC has to be stored in the order its bits enter the register, i.e. LittleEndian if both ReflectIn and ReflectOut
+// are set, BitReversed if only ReflectOut is, and BigEndian otherwise (see ByteOrder).
+//
+// Error patterns of 1, 2 and so on up to maxBits bits are searched for the one producing the syndrome, which is
+// the difference between CRC stored in the frame and CRC calculated over the message. If exactly one pattern
+// of the smallest weight is found, it returns a corrected copy of the frame and sorted positions of the bits
+// flipped, where bit position p stands for bit 0x80>>(p%8) of byte p/8. Intact frame is returned as is
+// with no positions. frame itself is never modified.
+//
+// Whether errors can be corrected reliably depends on the polynomial and the frame length: e.g. CRC-16/IBM-3740
+// corrects any single bit error in frames of up to 4095 bytes, and some 8-bit CRCs do so for frames of up to
+// 15 bytes. If maxBits is too high for the CRC, Correct is likely to return wrong corrections or
+// ErrAmbiguousCorrection. Syndromes of single bit errors are computed every time a corrupted frame is found,
+// which takes O(n) time for n bits in a frame, and then the search takes O(n^(maxBits-1)) time.
+// For frames of a fixed length, use a Corrector to compute syndromes once.
+func (t *Table) Correct(frame []byte, maxBits int) (corrected []byte, positions []int, err error) {
+	return t.correct(frame, maxBits, nil)
+}
+
+// Corrector corrects bit errors in frames of a fixed length the same way as Table.Correct does, using syndromes
+// of single bit errors computed once by NewCorrector. It holds a syndrome and a map entry for every bit of a frame,
+// so it takes roughly 100 bytes per bit, and is immutable once initialized, so it can be used concurrently.
+type Corrector struct {
+	table     *Table
+	length    int
+	syndromes *syndromeTable
+}
+
+// NewCorrector creates a Corrector for frames of frameLen bytes, including CRC.
+func (t *Table) NewCorrector(frameLen int) (*Corrector, error) {
+	if frameLen < int(t.crcParams.Width+7)/8 {
+		return nil, ErrFrameTooShort
+	}
+	return &Corrector{t, frameLen, t.syndromeTable(frameLen)}, nil
+}
+
+// Correct tries to correct bit errors in frame, see Table.Correct. frame must be exactly as long
+// as NewCorrector was told, otherwise ErrFrameLength is returned.
+func (c *Corrector) Correct(frame []byte, maxBits int) (corrected []byte, positions []int, err error) {
+	if len(frame) != c.length {
+		return nil, nil, ErrFrameLength
+	}
+	return c.table.correct(frame, maxBits, c.syndromes)
+}
+
+// correct implements Correct, using syndromes of single bit errors st if not nil, or computing them if needed
+func (t *Table) correct(frame []byte, maxBits int, st *syndromeTable) (corrected []byte, positions []int, err error) {
+	width := t.crcParams.Width
+	size := int(width+7) / 8
+	if len(frame) < size {
+		return nil, nil, ErrFrameTooShort
+	}
+	message, stored := frame[:len(frame)-size], frame[len(frame)-size:]
+	syndrome := t.frameOrder().Decode(stored, width) ^ t.CalculateCRC(message)
+	if syndrome == 0 {
+		return frame, nil, nil
+	}
+
+	if st == nil {
+		st = t.syndromeTable(len(frame))
+	}
+	for weight := 1; weight <= maxBits; weight++ {
+		var found []int
+		count := st.search(syndrome, weight, 0, nil, &found)
+		if count == 0 {
+			continue
+		}
+		if count > 1 {
+			return nil, nil, ErrAmbiguousCorrection
+		}
+		corrected = append([]byte(nil), frame...)
+		for _, p := range found {
+			corrected[p/8] ^= 0x80 >> uint(p%8)
+		}
+		return corrected, found, nil
+	}
+	return nil, nil, ErrUncorrectable
+}
+
+// frameOrder returns byte order of CRC in frames Correct works with
+func (t *Table) frameOrder() ByteOrder {
+	if order := t.registerOrder(); order >= 0 {
+		return order
+	}
+	return BigEndian
+}
+
+// search counts error patterns of a given weight producing syndrome, using bit positions starting from first
+// in addition to those already in pattern. The last pattern found is stored in found.
+// The last bit of each pattern is found by syndrome lookup rather than by trying every position.
+func (st *syndromeTable) search(syndrome uint64, weight, first int, pattern []int, found *[]int) int {
+	if weight == 1 {
+		count := 0
+		for _, p := range st.positions[syndrome] {
+			if p >= first {
+				*found = append(append([]int(nil), pattern...), p)
+				count++
+			}
+		}
+		return count
+	}
+	count := 0
+	for p := first; p < len(st.syndromes) && count < 2; p++ {
+		if st.syndromes[p] != 0 {
+			count += st.search(syndrome^st.syndromes[p], weight-1, p+1, append(pattern, p), found)
+		}
+	}
+	return count
+}
+
+// syndromeTable computes syndromes of single bit errors in frames of a given length.
+func (t *Table) syndromeTable(length int) *syndromeTable {
+	order := t.frameOrder()
+	width, poly := t.crcParams.Width, t.crcParams.Polynomial
+	size := int(width+7) / 8
+	st := &syndromeTable{syndromes: make([]uint64, 8*length)}
+
+	// an error in the message changes calculated CRC by the register value after processing the error
+	// alone from zero, advanced over the rest of the message
+	var bitStates [8]uint64 // normal form, for the last byte of the message
+	for bit := range bitStates {
+		bitStates[bit] = t.toNormal(t.updateBytewise(0, []byte{0x80 >> uint(bit)}))
+	}
+	x8 := xPowMod(8, poly, width)
+	for i := length - size - 1; i >= 0; i-- {
+		for bit := range bitStates {
+			state := t.fromNormal(bitStates[bit])
+			if t.crcParams.ReflectOut != t.crcParams.ReflectIn {
+				state = reflect(state, width)
+			}
+			st.syndromes[8*i+bit] = state & t.mask
+			bitStates[bit] = mulMod(bitStates[bit], x8, poly, width)
+		}
+	}
+	// an error in the stored CRC changes it directly, unless it hits unused bits
+	unit := make([]byte, size)
+	for i := 0; i < size; i++ {
+		for bit := 0; bit < 8; bit++ {
+			unit[i] = 0x80 >> uint(bit)
+			st.syndromes[8*(length-size+i)+bit] = order.Decode(unit, width)
+		}
+		unit[i] = 0
+	}
+
+	st.positions = make(map[uint64][]int, len(st.syndromes))
+	for p, syndrome := range st.syndromes {
+		if syndrome != 0 {
+			st.positions[syndrome] = append(st.positions[syndrome], p)
+		}
+	}
+	return st
+}
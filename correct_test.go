@@ -0,0 +1,86 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCorrect(t *testing.T) {
+	message := []byte("telemetry frame #42")
+	for _, params := range []*Parameters{CCITT, X25, CRC16MODBUS, CRC32, CRC12UMTS, CRC8SMBUS, CRC64XZ} {
+		table := NewTable(params)
+		msg := message
+		if params.Width == 8 {
+			msg = message[:10]
+		}
+		order := table.registerOrder()
+		if order < 0 {
+			order = BigEndian
+		}
+		frame := order.Append(append([]byte(nil), msg...), table.CalculateCRC(msg), params.Width)
+
+		if corrected, positions, err := table.Correct(frame, 1); err != nil || positions != nil || !bytes.Equal(corrected, frame) {
+			t.Errorf("Intact %s frame reported as corrupted: positions %v, error %v", params.Name, positions, err)
+		}
+
+		// any single bit error, except in unused bits of CRC, has to be corrected
+		for p := 0; p < 8*len(frame); p++ {
+			damaged := append([]byte(nil), frame...)
+			damaged[p/8] ^= 0x80 >> uint(p%8)
+			if table.Verify(damaged, order) {
+				continue // unused bit
+			}
+			corrected, positions, err := table.Correct(damaged, 1)
+			if err != nil || len(positions) != 1 || positions[0] != p || !bytes.Equal(corrected, frame) {
+				t.Errorf("Incorrect correction of %s frame with bit %d flipped: positions %v, error %v", params.Name, p, positions, err)
+			}
+		}
+		if _, _, err := table.Correct(frame[:(params.Width+7)/8-1], 1); err != ErrFrameTooShort {
+			t.Errorf("Unexpected error %v correcting short %s frame (should be ErrFrameTooShort)", err, params.Name)
+		}
+	}
+
+	// CRC-32 has Hamming distance of 6 for short frames, so it can correct double bit errors too
+	table := NewTable(CRC32)
+	frame := LittleEndian.Append(append([]byte(nil), message...), table.CalculateCRC(message), 32)
+	for _, pair := range [][2]int{{0, 1}, {3, 100}, {17, 8*len(frame) - 1}, {160, 161}} {
+		damaged := append([]byte(nil), frame...)
+		damaged[pair[0]/8] ^= 0x80 >> uint(pair[0]%8)
+		damaged[pair[1]/8] ^= 0x80 >> uint(pair[1]%8)
+		if _, _, err := table.Correct(damaged, 1); err != ErrUncorrectable {
+			t.Errorf("Unexpected error %v correcting double bit error %v with maxBits 1 (should be ErrUncorrectable)", err, pair)
+		}
+		corrected, positions, err := table.Correct(damaged, 2)
+		if err != nil || len(positions) != 2 || positions[0] != pair[0] || positions[1] != pair[1] || !bytes.Equal(corrected, frame) {
+			t.Errorf("Incorrect correction of double bit error %v: positions %v, error %v", pair, positions, err)
+		}
+	}
+}
+
+func TestCorrector(t *testing.T) {
+	table := NewTable(CCITT)
+	message := []byte("fixed length radio frame")
+	frame := BigEndian.Append(append([]byte(nil), message...), table.CalculateCRC(message), 16)
+	corrector, err := table.NewCorrector(len(frame))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []int{0, 9, 8*len(frame) - 1} {
+		damaged := append([]byte(nil), frame...)
+		damaged[p/8] ^= 0x80 >> uint(p%8)
+		corrected, positions, err := corrector.Correct(damaged, 1)
+		if err != nil || len(positions) != 1 || positions[0] != p || !bytes.Equal(corrected, frame) {
+			t.Errorf("Incorrect correction of frame with bit %d flipped: positions %v, error %v", p, positions, err)
+		}
+	}
+	if _, _, err := corrector.Correct(frame[1:], 1); err != ErrFrameLength {
+		t.Errorf("Unexpected error %v correcting frame of different length (should be ErrFrameLength)", err)
+	}
+	if _, err := table.NewCorrector(1); err != ErrFrameTooShort {
+		t.Errorf("Unexpected error %v creating Corrector for 1 byte frames (should be ErrFrameTooShort)", err)
+	}
+}
@@ -2,15 +2,20 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package crc implements generic CRC calculations up to 64 bits wide.
+// Package crc implements generic CRC calculations up to 128 bits wide.
 // It aims to be fairly complete, allowing users to match pretty much
 // any CRC algorithm used in the wild by choosing appropriate Parameters.
 // And it's also fairly fast for everyday use.
 //
+// Parameters, Table and Hash handle CRCs up to 64 bits wide. For wider ones,
+// like CRC-82/DARC, use WideParameters with WideTable or WideHash.
+//
 // This package has been largely inspired by Ross Williams' 1993 paper "A Painless Guide to CRC Error Detection Algorithms".
 // A good list of parameter sets for various CRC algorithms can be found at http://reveng.sourceforge.net/crc-catalogue/.
 package crc
 
+import "sync"
+
 // Parameters represents set of parameters defining a particular CRC algorithm.
 type Parameters struct {
 	Width      uint   // Width of the CRC expressed in bits
@@ -19,35 +24,39 @@ type Parameters struct {
 	ReflectOut bool   // ReflectOut indicates whether input bytes should be reflected
 	Init       uint64 // Init is initial value for CRC calculation
 	FinalXor   uint64 // Xor is a value for final xor to be applied before returning result
+	Check      uint64 // Check is CRC of ASCII string "123456789", see SelfTest
+	Residue    uint64 // Residue is the register content after processing a valid codeword, before final xor, see SelfTest
+	Name       string // Name of the CRC algorithm as listed in CRC RevEng catalogue, empty if not known
 }
 
 var (
 	// X-25 CRC parameters, also known as CRC-16/IBM-SDLC, CRC-16/ISO-HDLC, CRC-B
-	X25 = &Parameters{Width: 16, Polynomial: 0x1021, Init: 0xFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFF}
-	// CCITT CRC parameters
-	CCITT = &Parameters{Width: 16, Polynomial: 0x1021, Init: 0xFFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0x0}
+	X25 = &Parameters{Name: "CRC-16/IBM-SDLC", Width: 16, Polynomial: 0x1021, Init: 0xFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFF, Check: 0x906E, Residue: 0xF0B8}
+	// CCITT CRC parameters, also known as CRC-16/IBM-3740, CRC-16/CCITT-FALSE
+	CCITT = &Parameters{Name: "CRC-16/IBM-3740", Width: 16, Polynomial: 0x1021, Init: 0xFFFF, ReflectIn: false, ReflectOut: false, FinalXor: 0x0, Check: 0x29B1, Residue: 0x0000}
 	// CRC16 CRC parameters, also known as ARC
-	CRC16 = &Parameters{Width: 16, Polynomial: 0x8005, Init: 0x0000, ReflectIn: true, ReflectOut: true, FinalXor: 0x0}
+	CRC16 = &Parameters{Name: "CRC-16/ARC", Width: 16, Polynomial: 0x8005, Init: 0x0000, ReflectIn: true, ReflectOut: true, FinalXor: 0x0, Check: 0xBB3D, Residue: 0x0000}
 	// XMODEM is a set of CRC parameters commonly referred as "XMODEM"
-	XMODEM = &Parameters{Width: 16, Polynomial: 0x1021, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0x0}
-	// XMODEM2 is another set of CRC parameters commonly referred as "XMODEM"
-	XMODEM2 = &Parameters{Width: 16, Polynomial: 0x8408, Init: 0x0000, ReflectIn: true, ReflectOut: true, FinalXor: 0x0}
+	XMODEM = &Parameters{Name: "CRC-16/XMODEM", Width: 16, Polynomial: 0x1021, Init: 0x0000, ReflectIn: false, ReflectOut: false, FinalXor: 0x0, Check: 0x31C3, Residue: 0x0000}
+	// XMODEM2 is another set of CRC parameters commonly referred as "XMODEM".
+	// Note that its polynomial is even, so these parameters do not pass Validate.
+	XMODEM2 = &Parameters{Width: 16, Polynomial: 0x8408, Init: 0x0000, ReflectIn: true, ReflectOut: true, FinalXor: 0x0, Check: 0x0C73, Residue: 0x0000}
 
 	// CRC32 is by far the the most commonly used CRC-32 polynom and set of parameters
-	CRC32 = &Parameters{Width: 32, Polynomial: 0x04C11DB7, Init: 0xFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFFFFFF}
+	CRC32 = &Parameters{Name: "CRC-32/ISO-HDLC", Width: 32, Polynomial: 0x04C11DB7, Init: 0xFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFFFFFF, Check: 0xCBF43926, Residue: 0xDEBB20E3}
 	// IEEE is an alias to CRC32
 	IEEE = CRC32
 	// Castagnoli polynomial. used in iSCSI. And also provided by hash/crc32 package.
-	Castagnoli = &Parameters{Width: 32, Polynomial: 0x1EDC6F41, Init: 0xFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFFFFFF}
+	Castagnoli = &Parameters{Name: "CRC-32/ISCSI", Width: 32, Polynomial: 0x1EDC6F41, Init: 0xFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFFFFFF, Check: 0xE3069283, Residue: 0xB798B438}
 	// CRC32C is an alias to Castagnoli
 	CRC32C = Castagnoli
 	// Koopman polynomial
-	Koopman = &Parameters{Width: 32, Polynomial: 0x741B8CD7, Init: 0xFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFFFFFF}
+	Koopman = &Parameters{Width: 32, Polynomial: 0x741B8CD7, Init: 0xFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFFFFFF, Check: 0x2D3DD0AE, Residue: 0x0843323B}
 
 	// CRC64ISO is set of parameters commonly known as CRC64-ISO
-	CRC64ISO = &Parameters{Width: 64, Polynomial: 0x000000000000001B, Init: 0xFFFFFFFFFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFFFFFFFFFFFFFF}
-	// CRC64ECMA is set of parameters commonly known as CRC64-ECMA
-	CRC64ECMA = &Parameters{Width: 64, Polynomial: 0x42F0E1EBA9EA3693, Init: 0xFFFFFFFFFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFFFFFFFFFFFFFF}
+	CRC64ISO = &Parameters{Name: "CRC-64/GO-ISO", Width: 64, Polynomial: 0x000000000000001B, Init: 0xFFFFFFFFFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFFFFFFFFFFFFFF, Check: 0xB90956C775A41001, Residue: 0x5300000000000000}
+	// CRC64ECMA is set of parameters commonly known as CRC64-ECMA, also known as CRC-64/XZ
+	CRC64ECMA = &Parameters{Name: "CRC-64/XZ", Width: 64, Polynomial: 0x42F0E1EBA9EA3693, Init: 0xFFFFFFFFFFFFFFFF, ReflectIn: true, ReflectOut: true, FinalXor: 0xFFFFFFFFFFFFFFFF, Check: 0x995DC9BBDF1939FA, Residue: 0x49958C9ABD7D353F}
 )
 
 // reflect reverses order of last count bits
@@ -113,18 +122,58 @@ type Table struct {
 	crctable  []uint64
 	mask      uint64
 	initValue uint64
+
+	slicingOnce sync.Once       // guards lazy initialization of slicing
+	slicing     *[8][256]uint64 // tables for slicing-by-8 algorithm, see slicing.go
+	clmul       *[4]uint64      // carry-less multiplication folding constants if supported, see clmul.go
+	reverseOnce sync.Once       // guards lazy initialization of reverse
+	reverse     *[256]byte      // table index by distinguishing byte of its entry, see unupdate.go
 }
 
 // NewTable creates and initializes a new Table for the CRC algorithm specified by the crcParams.
+// Tables for the same Width, Polynomial and ReflectIn share the underlying table, which is either
+// precomputed (for built-in parameter sets) or kept in a cache, see cache.go.
 func NewTable(crcParams *Parameters) *Table {
-	ret := &Table{crcParams: *crcParams}
+	return newTableWith(crcParams, buildTable(crcParams))
+}
+
+// newOneOffTable is same as NewTable, except it does not add the table to the cache.
+// It is meant for Tables used once internally, e.g. to try candidate parameters.
+func newOneOffTable(crcParams *Parameters) *Table {
+	if table, ok := staticTables[tableKey{crcParams.Width, crcParams.Polynomial, crcParams.ReflectIn}]; ok {
+		return newTableWith(crcParams, table[:])
+	}
+	return newTableWith(crcParams, computeTable(crcParams))
+}
+
+// newTableWith creates and initializes a new Table using crctable already built for crcParams
+func newTableWith(crcParams *Parameters, crctable []uint64) *Table {
+	ret := &Table{crcParams: *crcParams, crctable: crctable}
 	ret.mask = (uint64(1) << crcParams.Width) - 1
-	ret.crctable = make([]uint64, 256, 256)
 	ret.initValue = crcParams.Init
 	if crcParams.ReflectIn {
 		ret.initValue = reflect(crcParams.Init, crcParams.Width)
 	}
+	ret.initCLMUL()
+	return ret
+}
+
+// buildTable returns the table for the CRC algorithm specified by the crcParams,
+// taking it from precomputed tables or the cache if possible.
+func buildTable(crcParams *Parameters) []uint64 {
+	key := tableKey{crcParams.Width, crcParams.Polynomial, crcParams.ReflectIn}
+	if table, ok := staticTables[key]; ok {
+		return table[:]
+	}
+	if table, ok := cachedTable(key); ok {
+		return table
+	}
+	return cacheTable(key, computeTable(crcParams))
+}
 
+// computeTable calculates the table for the CRC algorithm specified by the crcParams bit by bit
+func computeTable(crcParams *Parameters) []uint64 {
+	table := make([]uint64, 256, 256)
 	tmp := make([]byte, 1, 1)
 	tableParams := *crcParams
 	tableParams.Init = 0
@@ -132,9 +181,18 @@ func NewTable(crcParams *Parameters) *Table {
 	tableParams.FinalXor = 0
 	for i := 0; i < 256; i++ {
 		tmp[0] = byte(i)
-		ret.crctable[i] = CalculateCRC(&tableParams, tmp)
+		table[i] = CalculateCRC(&tableParams, tmp)
 	}
-	return ret
+	return table
+}
+
+// NewTableE is same as NewTable, except it validates crcParams first
+// and returns an error instead of a Table calculating wrong CRC values if they are invalid.
+func NewTableE(crcParams *Parameters) (*Table, error) {
+	if err := crcParams.Validate(); err != nil {
+		return nil, err
+	}
+	return NewTable(crcParams), nil
 }
 
 // InitCrc returns a stating value for a new CRC calculation
@@ -145,6 +203,17 @@ func (t *Table) InitCrc() uint64 {
 // UpdateCrc process supplied bytes and updates current (partial) CRC accordingly.
 // It can be called repetitively to process larger data in chunks.
 func (t *Table) UpdateCrc(curValue uint64, p []byte) uint64 {
+	if t.clmul != nil && len(p) >= clmulThreshold {
+		curValue, p = t.updateCLMUL(curValue, p)
+	}
+	if len(p) >= slicingThreshold {
+		return t.updateSlicing8(curValue, p)
+	}
+	return t.updateBytewise(curValue, p)
+}
+
+// updateBytewise implements UpdateCrc processing one byte at a time
+func (t *Table) updateBytewise(curValue uint64, p []byte) uint64 {
 	if t.crcParams.ReflectIn {
 		for _, v := range p {
 			curValue = t.crctable[(byte(curValue)^v)&0xFF] ^ (curValue >> 8)
@@ -189,6 +258,12 @@ func (t *Table) CRC32(curValue uint64) uint32 {
 	return uint32(t.CRC(curValue))
 }
 
+// CRC64 is a convenience method to spare end users from explicit type conversion every time this package is used.
+// Underneath, it just calls CRC() method.
+func (t *Table) CRC64(curValue uint64) uint64 {
+	return t.CRC(curValue)
+}
+
 // CalculateCRC is a convenience function allowing to calculate CRC in one call.
 func (t *Table) CalculateCRC(data []byte) uint64 {
 	crc := t.InitCrc()
@@ -202,6 +277,7 @@ type Hash struct {
 	table    *Table
 	curValue uint64
 	size     uint
+	order    ByteOrder
 }
 
 // Size returns the number of bytes Sum will return.
@@ -223,14 +299,30 @@ func (h *Hash) Reset() {
 
 // Sum appends the current hash to b and returns the resulting slice.
 // It does not change the underlying hash state.
+// CRC is stored in byte order set by SetByteOrder, which is BigEndian by default.
 // See hash.Hash interface.
 func (h *Hash) Sum(in []byte) []byte {
-	s := h.CRC()
-	for i := h.size; i > 0; {
-		i--
-		in = append(in, byte(s>>(8*i)))
-	}
-	return in
+	return h.Append(in, h.CRC())
+}
+
+// SetByteOrder sets byte order used by Sum, Append and Decode.
+func (h *Hash) SetByteOrder(order ByteOrder) {
+	h.order = order
+}
+
+// ByteOrder returns byte order used by Sum, Append and Decode.
+func (h *Hash) ByteOrder() ByteOrder {
+	return h.order
+}
+
+// Append appends crc to dst in byte order used by this Hash and returns the resulting slice.
+func (h *Hash) Append(dst []byte, crc uint64) []byte {
+	return h.order.Append(dst, crc, h.table.crcParams.Width)
+}
+
+// Decode reads crc stored by Sum or Append from b, which must be at least Size() bytes long.
+func (h *Hash) Decode(b []byte) uint64 {
+	return h.order.Decode(b, h.table.crcParams.Width)
 }
 
 // Write implements io.Writer interface which is part of hash.Hash interface.
@@ -244,6 +336,11 @@ func (h *Hash) Update(p []byte) {
 	h.curValue = h.table.UpdateCrc(h.curValue, p)
 }
 
+// UpdateBits processes first nbits bits of data and updates current (partial) CRC accordingly, see Table.UpdateBits.
+func (h *Hash) UpdateBits(data []byte, nbits int, msbFirst bool) {
+	h.curValue = h.table.UpdateBits(h.curValue, data, nbits, msbFirst)
+}
+
 // CRC returns current CRC value for the data processed so far.
 func (h *Hash) CRC() uint64 {
 	return h.table.CRC(h.curValue)
@@ -269,6 +366,16 @@ func NewHash(crcParams *Parameters) *Hash {
 	return NewHashWithTable(NewTable(crcParams))
 }
 
+// NewHashE is same as NewHash, except it validates crcParams first
+// and returns an error instead of a Hash calculating wrong CRC values if they are invalid.
+func NewHashE(crcParams *Parameters) (*Hash, error) {
+	table, err := NewTableE(crcParams)
+	if err != nil {
+		return nil, err
+	}
+	return NewHashWithTable(table), nil
+}
+
 // CRC8 is a convenience method to spare end users from explicit type conversion every time this package is used.
 // Underneath, it just calls CRC() method.
 func (h *Hash) CRC8() uint8 {
@@ -287,7 +394,42 @@ func (h *Hash) CRC32() uint32 {
 	return h.table.CRC32(h.curValue)
 }
 
+// CRC64 is a convenience method to spare end users from explicit type conversion every time this package is used.
+// Underneath, it just calls CRC() method.
+func (h *Hash) CRC64() uint64 {
+	return h.table.CRC64(h.curValue)
+}
+
 // Table used by this Hash under the hood
 func (h *Hash) Table() *Table {
 	return h.table
 }
+
+// Sum64 returns current CRC value, same as CRC() does. It makes Hash implement hash.Hash64 interface,
+// so Hash can be used wherever hash/crc64 would be.
+func (h *Hash) Sum64() uint64 {
+	return h.CRC()
+}
+
+// Hash32 is a Hash for CRC algorithms up to 32 bits wide. Unlike Hash it also implements hash.Hash32 interface,
+// so it can be used wherever hash/crc32 would be.
+type Hash32 struct {
+	Hash
+}
+
+// Sum32 returns current CRC value. See hash.Hash32 interface.
+func (h *Hash32) Sum32() uint32 {
+	return h.CRC32()
+}
+
+// NewHash32 creates a new Hash32 instance configured for table driven CRC calculation according to
+// parameters specified. It returns an error if parameters are not valid or CRC is wider than 32 bits.
+func NewHash32(crcParams *Parameters) (*Hash32, error) {
+	if err := crcParams.Validate(); err != nil {
+		return nil, err
+	}
+	if crcParams.Width > 32 {
+		return nil, &ParametersError{"Width", uint64(crcParams.Width), ErrWidthOutOfRange}
+	}
+	return &Hash32{Hash: *NewHash(crcParams)}, nil
+}
@@ -244,3 +244,42 @@ func BenchmarkCCITT(b *testing.B) {
 		tableDriven.CRC()
 	}
 }
+
+func TestHash32And64Interfaces(t *testing.T) {
+	data := []byte("123456789")
+
+	var h64 hash.Hash64 = NewHash(CRC64ECMA)
+	h64.Write(data)
+	if sum := h64.Sum64(); sum != 0x995DC9BBDF1939FA {
+		t.Errorf("Incorrect Sum64 0x%016x calculated (should be 0x995DC9BBDF1939FA)", sum)
+	}
+
+	h32, err := NewHash32(CRC32)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var h hash.Hash32 = h32
+	h.Write(data)
+	if sum := h.Sum32(); sum != 0xCBF43926 {
+		t.Errorf("Incorrect Sum32 0x%08x calculated (should be 0xCBF43926)", sum)
+	}
+	h.Reset()
+	h.Write([]byte("1234"))
+	h.Write([]byte("56789"))
+	if sum := h.Sum32(); sum != 0xCBF43926 {
+		t.Errorf("Incorrect Sum32 0x%08x calculated after Reset (should be 0xCBF43926)", sum)
+	}
+
+	h16, err := NewHash32(CCITT)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	h16.Write(data)
+	if sum := h16.Sum32(); sum != 0x29B1 || h16.Size() != 2 {
+		t.Errorf("Incorrect Sum32 0x%08x or size %d calculated (should be 0x29B1 and 2)", sum, h16.Size())
+	}
+
+	if _, err := NewHash32(CRC64ECMA); err == nil {
+		t.Errorf("NewHash32 accepted 64 bit wide CRC")
+	}
+}
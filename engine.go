@@ -0,0 +1,216 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import "fmt"
+
+// Engine is implemented by all table driven and table-less CRC implementations, including Table.
+// Values passed between InitCrc, UpdateCrc and CRC are internal register values, which are specific
+// to the kind of Engine, so do not mix different engines in a single calculation.
+type Engine interface {
+	// InitCrc returns a stating value for a new CRC calculation
+	InitCrc() uint64
+	// UpdateCrc process supplied bytes and updates current (partial) CRC accordingly.
+	UpdateCrc(curValue uint64, p []byte) uint64
+	// CRC returns CRC value for the data processed so far.
+	CRC(curValue uint64) uint64
+	// CalculateCRC is a convenience function allowing to calculate CRC in one call.
+	CalculateCRC(data []byte) uint64
+}
+
+// EngineKind selects CRC implementation created by NewEngine, trading speed for memory footprint.
+type EngineKind int
+
+const (
+	// TableEngine is the Table, which is the fastest, but takes 2 KB for its table,
+	// plus 16 KB for slicing-by-8 tables built on first use with large inputs.
+	TableEngine EngineKind = iota
+	// SizedTableEngine uses a table of 256 entries of the smallest unsigned integer type the CRC fits into,
+	// i.e. 256 bytes for CRC-8 and 512 bytes for CRC-16. It is a bit slower than Table for large inputs.
+	SizedTableEngine
+	// NibbleEngine uses a table of 16 entries processing 4 bits at a time. It takes 128 bytes
+	// and is about twice as slow as SizedTableEngine.
+	NibbleEngine
+	// BitwiseEngine uses no table at all and processes input bit by bit, which is the slowest.
+	BitwiseEngine
+)
+
+// String returns the name of the engine kind
+func (k EngineKind) String() string {
+	switch k {
+	case TableEngine:
+		return "TableEngine"
+	case SizedTableEngine:
+		return "SizedTableEngine"
+	case NibbleEngine:
+		return "NibbleEngine"
+	case BitwiseEngine:
+		return "BitwiseEngine"
+	}
+	return fmt.Sprintf("EngineKind(%d)", int(k))
+}
+
+// NewEngine creates a CRC implementation of a given kind for the CRC algorithm specified by crcParams.
+// It validates crcParams first, see Validate.
+func NewEngine(crcParams *Parameters, kind EngineKind) (Engine, error) {
+	if err := crcParams.Validate(); err != nil {
+		return nil, err
+	}
+	base := newEngineBase(crcParams)
+	switch kind {
+	case TableEngine:
+		return NewTable(crcParams), nil
+	case SizedTableEngine:
+		switch {
+		case crcParams.Width <= 8:
+			return newSizedTable[uint8](crcParams)
+		case crcParams.Width <= 16:
+			return newSizedTable[uint16](crcParams)
+		case crcParams.Width <= 32:
+			return newSizedTable[uint32](crcParams)
+		}
+		return newSizedTable[uint64](crcParams)
+	case NibbleEngine:
+		return newNibbleEngine(base), nil
+	case BitwiseEngine:
+		return &bitwiseEngine{base}, nil
+	}
+	return nil, fmt.Errorf("crc: unknown engine kind %v", kind)
+}
+
+// engineBase holds what all engines other than Table share. They keep the register the same way as Table
+// does between calls, i.e. reflected if ReflectIn is set.
+type engineBase struct {
+	crcParams Parameters
+	register  bitRegister
+	initValue uint64
+}
+
+func newEngineBase(crcParams *Parameters) engineBase {
+	base := engineBase{crcParams: *crcParams, register: newBitRegister(crcParams), initValue: crcParams.Init}
+	if crcParams.ReflectIn {
+		base.initValue = reflect(crcParams.Init, crcParams.Width)
+	}
+	return base
+}
+
+// InitCrc returns a stating value for a new CRC calculation
+func (e *engineBase) InitCrc() uint64 {
+	return e.initValue
+}
+
+// CRC returns CRC value for the data processed so far.
+func (e *engineBase) CRC(curValue uint64) uint64 {
+	if e.crcParams.ReflectOut != e.crcParams.ReflectIn {
+		curValue = reflect(curValue, e.crcParams.Width)
+	}
+	return (curValue ^ e.crcParams.FinalXor) & e.register.mask
+}
+
+// updateBits processes count least significant bits of value from zero register, starting from the most
+// significant one of them, or the least significant one if ReflectIn is set. It is used to build tables.
+func (e *engineBase) updateBits(value uint64, count uint) uint64 {
+	var state uint64
+	for i := uint(0); i < count; i++ {
+		if e.crcParams.ReflectIn {
+			state = e.register.update(state, value&(1<<i) != 0)
+		} else {
+			state = e.register.update(state, value&(1<<(count-i-1)) != 0)
+		}
+	}
+	return state
+}
+
+// bitwiseEngine processes input bit by bit without any table
+type bitwiseEngine struct {
+	engineBase
+}
+
+func (e *bitwiseEngine) UpdateCrc(curValue uint64, p []byte) uint64 {
+	curValue &= e.register.mask
+	for _, v := range p {
+		for i := uint(0); i < 8; i++ {
+			if e.crcParams.ReflectIn {
+				curValue = e.register.update(curValue, v&(1<<i) != 0)
+			} else {
+				curValue = e.register.update(curValue, v&(0x80>>i) != 0)
+			}
+		}
+	}
+	return curValue
+}
+
+func (e *bitwiseEngine) CalculateCRC(data []byte) uint64 {
+	return e.CRC(e.UpdateCrc(e.InitCrc(), data))
+}
+
+// nibbleEngine processes input 4 bits at a time using a table of 16 entries. If ReflectIn is not set,
+// register is kept aligned to the most significant bit of uint64 while processing, so any width works.
+type nibbleEngine struct {
+	engineBase
+	table [16]uint64
+}
+
+func newNibbleEngine(base engineBase) *nibbleEngine {
+	e := &nibbleEngine{engineBase: base}
+	for i := range e.table {
+		e.table[i] = e.updateBits(uint64(i), 4)
+		if !e.crcParams.ReflectIn {
+			e.table[i] <<= 64 - e.crcParams.Width
+		}
+	}
+	return e
+}
+
+func (e *nibbleEngine) UpdateCrc(curValue uint64, p []byte) uint64 {
+	curValue &= e.register.mask
+	if e.crcParams.ReflectIn {
+		for _, v := range p {
+			curValue = e.table[(curValue^uint64(v))&0xF] ^ (curValue >> 4)
+			curValue = e.table[(curValue^uint64(v>>4))&0xF] ^ (curValue >> 4)
+		}
+		return curValue
+	}
+	shift := 64 - e.crcParams.Width
+	curValue <<= shift
+	for _, v := range p {
+		curValue = e.table[(curValue>>60)^uint64(v>>4)] ^ (curValue << 4)
+		curValue = e.table[(curValue>>60)^uint64(v&0xF)] ^ (curValue << 4)
+	}
+	return curValue >> shift
+}
+
+func (e *nibbleEngine) CalculateCRC(data []byte) uint64 {
+	return e.CRC(e.UpdateCrc(e.InitCrc(), data))
+}
+
+// sizedTable adapts TypedTable to Engine interface
+type sizedTable[T Unsigned] struct {
+	typed *TypedTable[T]
+}
+
+func newSizedTable[T Unsigned](crcParams *Parameters) (Engine, error) {
+	typed, err := NewTypedTable[T](crcParams)
+	if err != nil {
+		return nil, err
+	}
+	return sizedTable[T]{typed}, nil
+}
+
+func (e sizedTable[T]) InitCrc() uint64 {
+	return uint64(e.typed.InitCrc())
+}
+
+func (e sizedTable[T]) UpdateCrc(curValue uint64, p []byte) uint64 {
+	return uint64(e.typed.UpdateCrc(T(curValue), p))
+}
+
+func (e sizedTable[T]) CRC(curValue uint64) uint64 {
+	return uint64(e.typed.CRC(T(curValue)))
+}
+
+func (e sizedTable[T]) CalculateCRC(data []byte) uint64 {
+	return uint64(e.typed.CalculateCRC(data))
+}
@@ -0,0 +1,55 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"errors"
+	"testing"
+)
+
+var engineKinds = []EngineKind{TableEngine, SizedTableEngine, NibbleEngine, BitwiseEngine}
+
+func TestEngines(t *testing.T) {
+	data := []byte("engines have to agree with each other, whatever their size")
+	for _, entry := range catalogue {
+		params := entry.params
+		expected := CalculateCRC(params, data)
+		for _, kind := range engineKinds {
+			engine, err := NewEngine(params, kind)
+			if err != nil {
+				t.Fatalf("Unexpected error creating %v for %s: %v", kind, params.Name, err)
+			}
+			if crc := engine.CalculateCRC(checkInput); crc != params.Check {
+				t.Errorf("Incorrect %s check value 0x%X calculated by %v (should be 0x%X)", params.Name, crc, kind, params.Check)
+			}
+			state := engine.InitCrc()
+			state = engine.UpdateCrc(state, data[:13])
+			state = engine.UpdateCrc(state, data[13:])
+			if crc := engine.CRC(state); crc != expected {
+				t.Errorf("Incorrect %s CRC 0x%X calculated by %v in chunks (should be 0x%X)", params.Name, crc, kind, expected)
+			}
+		}
+	}
+
+	if _, err := NewEngine(&Parameters{Width: 16, Polynomial: 0x1020}, NibbleEngine); !errors.Is(err, ErrEvenPolynomial) {
+		t.Errorf("Unexpected error %v creating engine for even polynomial (should be ErrEvenPolynomial)", err)
+	}
+	if _, err := NewEngine(CRC32, EngineKind(42)); err == nil {
+		t.Errorf("No error creating engine of unknown kind")
+	}
+}
+
+func BenchmarkEngines(b *testing.B) {
+	data := make([]byte, 4096)
+	for _, kind := range engineKinds {
+		engine, _ := NewEngine(CRC32, kind)
+		b.Run(kind.String(), func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				engine.UpdateCrc(engine.InitCrc(), data)
+			}
+		})
+	}
+}
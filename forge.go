@@ -0,0 +1,67 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import "errors"
+
+// Errors reported by Forge
+var (
+	// ErrPatchOutOfRange means the patch does not fit into data at the offset requested.
+	ErrPatchOutOfRange = errors.New("crc: patch does not fit into data")
+	// ErrNoPatch means no patch at the offset requested produces the target CRC.
+	// It never happens for valid Parameters, as any Width consecutive bits are enough to get any CRC.
+	ErrNoPatch = errors.New("crc: no patch produces target CRC")
+)
+
+// Forge computes (Width+7)/8 bytes which, written over data at offset (i.e. replacing
+// data[offset:offset+(Width+7)/8]), make CRC of whole data equal target. data itself is not modified.
+// To insert a patch rather than overwrite existing bytes, make room for it in data first (any contents will do).
+//
+// Forge works for any Parameters. CRC is linear, so the patch is found by solving a system of Width linear
+// equations over GF(2) for the bits to flip in the original bytes. If Width is not a multiple of 8, only as many
+// bits as needed are flipped and the rest keep their original values, so e.g. for a 12-bit CRC four bits of
+// the patch (which can be chosen by the caller as they are taken from data) are left intact.
+func (t *Table) Forge(data []byte, offset int, target uint64) ([]byte, error) {
+	width, poly := t.crcParams.Width, t.crcParams.Polynomial
+	size := int(width+7) / 8
+	if offset < 0 || offset > len(data)-size {
+		return nil, ErrPatchOutOfRange
+	}
+	original := data[offset : offset+size]
+
+	// flipping a bit of the patch changes the register by the same amount regardless of the rest of data,
+	// which is the register after processing the flipped bit alone from zero, advanced over the tail of data
+	tail := uint64(len(data) - offset - size)
+	factor := powMod(xPowMod(8, poly, width), tail, poly, width)
+	var effects [64]uint64
+	unit := make([]byte, size)
+	for i := range unit {
+		for bit := uint(0); bit < 8; bit++ {
+			unit[i] = 1 << bit
+			effects[8*i+int(bit)] = mulMod(t.toNormal(t.updateBytewise(0, unit)), factor, poly, width)
+		}
+		unit[i] = 0
+	}
+
+	current := t.UpdateCrc(t.InitCrc(), data)
+	diff := t.toNormal(current ^ t.StateFromCRC(target))
+	equations := make([]gf2Equation, width)
+	for row := uint(0); row < width; row++ {
+		equations[row].rhs = diff >> row
+		for j := 0; j < 8*size; j++ {
+			equations[row].coef |= ((effects[j] >> row) & 1) << uint(j)
+		}
+	}
+	flips, _, ok := solveGF2(equations, uint(8*size))
+	if !ok {
+		return nil, ErrNoPatch
+	}
+
+	patch := make([]byte, size)
+	for i := range patch {
+		patch[i] = original[i] ^ byte(flips>>(8*uint(i)))
+	}
+	return patch, nil
+}
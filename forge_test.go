@@ -0,0 +1,56 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"math/bits"
+	"testing"
+)
+
+func TestForge(t *testing.T) {
+	data := make([]byte, 300)
+	for i := range data {
+		data[i] = byte(i*7 + 3)
+	}
+
+	for _, entry := range catalogue {
+		params := entry.params
+		table := NewTable(params)
+		size := int(params.Width+7) / 8
+		target := 0xDEADBEEFCAFEF00D & widthMask(params.Width)
+		for _, offset := range []int{0, 1, 17, 150, len(data) - size} {
+			patch, err := table.Forge(data, offset, target)
+			if err != nil {
+				t.Errorf("Unexpected error forging %s at offset %d: %v", params.Name, offset, err)
+				continue
+			}
+			patched := append([]byte(nil), data...)
+			copy(patched[offset:], patch)
+			if crc := table.CalculateCRC(patched); crc != target {
+				t.Errorf("Incorrect CRC 0x%X of data forged for %s at offset %d (should be 0x%X)", crc, params.Name, offset, target)
+			}
+			flipped := 0
+			for i := range patch {
+				flipped += bits.OnesCount8(patch[i] ^ data[offset+i])
+			}
+			if flipped > int(params.Width) {
+				t.Errorf("Forging %s at offset %d flipped %d bits, more than CRC width", params.Name, offset, flipped)
+			}
+		}
+	}
+
+	// forging the current CRC should change nothing
+	table := NewTable(CRC32)
+	patch, err := table.Forge(data, 10, table.CalculateCRC(data))
+	if err != nil || string(patch) != string(data[10:14]) {
+		t.Errorf("Forging current CRC changed data: % X (should be % X), error %v", patch, data[10:14], err)
+	}
+
+	for _, offset := range []int{-1, len(data) - 3, len(data)} {
+		if _, err := table.Forge(data, offset, 0); err != ErrPatchOutOfRange {
+			t.Errorf("Unexpected error %v forging at offset %d (should be ErrPatchOutOfRange)", err, offset)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidModel means a line passed to ParseParameters is not a valid CRC RevEng model.
+// It is wrapped into an error describing the problem, so use errors.Is to check for it.
+var ErrInvalidModel = errors.New("crc: invalid model")
+
+// String formats Parameters the same way as CRC RevEng does in its catalogue, e.g.
+//
+//	width=16 poly=0x1021 init=0xffff refin=false refout=false xorout=0x0000 check=0x29b1 residue=0x0000 name="CRC-16/IBM-3740"
+//
+// Values are lowercase hexadecimal with as many digits as Width takes. Name is omitted if empty.
+func (crcParams *Parameters) String() string {
+	if crcParams == nil {
+		return "<nil>"
+	}
+	digits := int(crcParams.Width+3) / 4
+	var b strings.Builder
+	fmt.Fprintf(&b, "width=%d poly=0x%0*x init=0x%0*x refin=%t refout=%t xorout=0x%0*x check=0x%0*x residue=0x%0*x",
+		crcParams.Width, digits, crcParams.Polynomial, digits, crcParams.Init, crcParams.ReflectIn, crcParams.ReflectOut,
+		digits, crcParams.FinalXor, digits, crcParams.Check, digits, crcParams.Residue)
+	if crcParams.Name != "" {
+		fmt.Fprintf(&b, " name=%s", strconv.Quote(crcParams.Name))
+	}
+	return b.String()
+}
+
+// ParseParameters parses a CRC model in CRC RevEng syntax, as produced by String. Fields are separated by spaces
+// and may go in any order. Numbers may be hexadecimal (with 0x prefix) or decimal, and name may be quoted.
+// Width and poly are required, while the rest default to zero (or false). If check or residue is omitted,
+// it is calculated. Parsed Parameters are validated, see Validate.
+func ParseParameters(line string) (*Parameters, error) {
+	params := &Parameters{}
+	seen := make(map[string]bool)
+	for rest := strings.TrimSpace(line); rest != ""; rest = strings.TrimSpace(rest) {
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return nil, fmt.Errorf("%w: expected key=value at %q", ErrInvalidModel, rest)
+		}
+		key := rest[:eq]
+		rest = rest[eq+1:]
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			quoted, err := strconv.QuotedPrefix(rest)
+			if err != nil {
+				return nil, fmt.Errorf("%w: unterminated %s value", ErrInvalidModel, key)
+			}
+			rest = rest[len(quoted):]
+			value, _ = strconv.Unquote(quoted)
+		} else {
+			end := strings.IndexAny(rest, " \t")
+			if end < 0 {
+				end = len(rest)
+			}
+			value, rest = rest[:end], rest[end:]
+		}
+
+		if err := params.set(key, value); err != nil {
+			return nil, err
+		}
+		seen[key] = true
+	}
+
+	if !seen["width"] || !seen["poly"] {
+		return nil, fmt.Errorf("%w: width and poly are required", ErrInvalidModel)
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if !seen["check"] {
+		params.Check = CalculateCRC(params, checkInput)
+	}
+	if !seen["residue"] {
+		params.Residue = CalculateCRC(residueRun(params))
+	}
+	return params, nil
+}
+
+// set sets a field of Parameters given its name in CRC RevEng syntax
+func (crcParams *Parameters) set(key, value string) error {
+	if key == "name" {
+		crcParams.Name = value
+		return nil
+	}
+	if key == "refin" || key == "refout" {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%w: invalid %s value %q", ErrInvalidModel, key, value)
+		}
+		if key == "refin" {
+			crcParams.ReflectIn = b
+		} else {
+			crcParams.ReflectOut = b
+		}
+		return nil
+	}
+
+	var field *uint64
+	switch key {
+	case "poly":
+		field = &crcParams.Polynomial
+	case "init":
+		field = &crcParams.Init
+	case "xorout":
+		field = &crcParams.FinalXor
+	case "check":
+		field = &crcParams.Check
+	case "residue":
+		field = &crcParams.Residue
+	case "width":
+	default:
+		return fmt.Errorf("%w: unknown key %q", ErrInvalidModel, key)
+	}
+	n, err := strconv.ParseUint(value, 0, 64)
+	if err != nil {
+		return fmt.Errorf("%w: invalid %s value %q", ErrInvalidModel, key, value)
+	}
+	if field == nil {
+		crcParams.Width = uint(n)
+	} else {
+		*field = n
+	}
+	return nil
+}
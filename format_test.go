@@ -0,0 +1,60 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFormatParameters(t *testing.T) {
+	line := `width=16 poly=0x1021 init=0xffff refin=false refout=false xorout=0x0000 check=0x29b1 residue=0x0000 name="CRC-16/IBM-3740"`
+	if s := CCITT.String(); s != line {
+		t.Errorf("Incorrect format %q of CCITT (should be %q)", s, line)
+	}
+	params, err := ParseParameters(line)
+	if err != nil || *params != *CCITT {
+		t.Errorf("Incorrect parameters %v parsed from %q (should be %v), error %v", params, line, CCITT, err)
+	}
+
+	// every catalogue entry has to survive the round trip
+	for _, entry := range catalogue {
+		parsed, err := ParseParameters(entry.params.String())
+		if err != nil || *parsed != *entry.params {
+			t.Errorf("Incorrect parameters %v parsed back (should be %v), error %v", parsed, entry.params, err)
+		}
+	}
+	if s := CRC5USB.String(); s != `width=5 poly=0x05 init=0x1f refin=true refout=true xorout=0x1f check=0x19 residue=0x06 name="CRC-5/USB"` {
+		t.Errorf("Incorrect format %q of CRC-5/USB", s)
+	}
+
+	// decimal numbers, any order, check and residue calculated
+	params, err = ParseParameters(`  refout=true poly=0x8005 width=16   refin=true name=ARC init=0 xorout=0`)
+	if err != nil || params.Check != 0xBB3D || params.Residue != 0 || params.Name != "ARC" || !params.ReflectIn || !params.ReflectOut {
+		t.Errorf("Incorrect parameters %v parsed (should be CRC-16/ARC), error %v", params, err)
+	}
+	params, err = ParseParameters(`width=8 poly=7 name="with spaces \"quoted\""`)
+	if err != nil || params.Name != `with spaces "quoted"` || params.Check != CRC8SMBUS.Check {
+		t.Errorf("Incorrect parameters %v parsed with quoted name, error %v", params, err)
+	}
+
+	for _, line := range []string{
+		``,
+		`poly=0x1021`,
+		`width=16 poly=0x1021 color=red`,
+		`width=16 poly=0x10g1`,
+		`width=16 poly=0x1021 refin=maybe`,
+		`width=16 poly=0x1021 name="unterminated`,
+		`width=16 poly=0x1021 =0`,
+		`width=16 poly`,
+	} {
+		if _, err := ParseParameters(line); !errors.Is(err, ErrInvalidModel) {
+			t.Errorf("Unexpected error %v parsing %q (should be ErrInvalidModel)", err, line)
+		}
+	}
+	if _, err := ParseParameters(`width=16 poly=0x1020`); !errors.Is(err, ErrEvenPolynomial) {
+		t.Errorf("Unexpected error %v parsing even polynomial (should be ErrEvenPolynomial)", err)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import "math/bits"
+
+// Helpers below implement arithmetic in the ring of polynomials over GF(2) modulo P, where
+// P = x^width + poly is a CRC generator polynomial. Polynomials are represented in normal
+// (non reflected) form, i.e. bit n holds the coefficient of x^n, and are always less than P.
+
+// mulXMod returns a*x mod P
+func mulXMod(a, poly uint64, width uint) uint64 {
+	topBit := a & (uint64(1) << (width - 1))
+	a = (a << 1) & widthMask(width)
+	if topBit != 0 {
+		a ^= poly
+	}
+	return a
+}
+
+// mulMod returns a*b mod P
+func mulMod(a, b, poly uint64, width uint) uint64 {
+	var ret uint64
+	for i := int(width) - 1; i >= 0; i-- {
+		ret = mulXMod(ret, poly, width)
+		if b&(uint64(1)<<uint(i)) != 0 {
+			ret ^= a
+		}
+	}
+	return ret
+}
+
+// powMod returns a^n mod P. It takes O(log n) multiplications.
+func powMod(a, n uint64, poly uint64, width uint) uint64 {
+	ret := uint64(1)
+	for ; n != 0; n >>= 1 {
+		if n&1 != 0 {
+			ret = mulMod(ret, a, poly, width)
+		}
+		a = mulMod(a, a, poly, width)
+	}
+	return ret
+}
+
+// xPowMod returns x^n mod P
+func xPowMod(n uint64, poly uint64, width uint) uint64 {
+	return powMod(mulXMod(1, poly, width), n, poly, width)
+}
+
+// geomSumMod returns 1 + a + a^2 + ... + a^(n-1) mod P. It takes O(log n) multiplications.
+func geomSumMod(a, n uint64, poly uint64, width uint) uint64 {
+	// sum and power hold sum of first m powers and a^m for m being the leading bits of n processed so far
+	var sum uint64
+	power := uint64(1)
+	for bit := bits.Len64(n) - 1; bit >= 0; bit-- {
+		sum ^= mulMod(sum, power, poly, width)
+		power = mulMod(power, power, poly, width)
+		if n&(uint64(1)<<uint(bit)) != 0 {
+			sum ^= power
+			power = mulMod(power, a, poly, width)
+		}
+	}
+	return sum
+}
+
+// gf2Equation is a linear equation over GF(2) with up to 64 unknowns. Bit n of coef is
+// the coefficient of n-th unknown and the lowest bit of rhs is the right hand side.
+type gf2Equation struct {
+	coef uint64
+	rhs  uint64
+}
+
+// solveGF2 solves a system of linear equations over GF(2) with n unknowns. It returns a particular
+// solution (with all free unknowns set to zero) and a basis of the null space, so that every solution
+// is the particular one plus any combination of basis vectors. ok is false if there is no solution.
+func solveGF2(equations []gf2Equation, n uint) (solution uint64, nullspace []uint64, ok bool) {
+	eqs := append([]gf2Equation(nil), equations...)
+	pivots := make([]uint, 0, n)
+	rank := 0
+	for col := uint(0); col < n && rank < len(eqs); col++ {
+		bit := uint64(1) << col
+		pivot := -1
+		for i := rank; i < len(eqs); i++ {
+			if eqs[i].coef&bit != 0 {
+				pivot = i
+				break
+			}
+		}
+		if pivot < 0 {
+			continue
+		}
+		eqs[rank], eqs[pivot] = eqs[pivot], eqs[rank]
+		for i := range eqs {
+			if i != rank && eqs[i].coef&bit != 0 {
+				eqs[i].coef ^= eqs[rank].coef
+				eqs[i].rhs ^= eqs[rank].rhs
+			}
+		}
+		pivots = append(pivots, col)
+		rank++
+	}
+	for _, eq := range eqs[rank:] {
+		if eq.rhs&1 != 0 {
+			return 0, nil, false
+		}
+	}
+
+	var pivotMask uint64
+	for i, col := range pivots {
+		pivotMask |= uint64(1) << col
+		solution |= (eqs[i].rhs & 1) << col
+	}
+	for free := uint(0); free < n; free++ {
+		if pivotMask&(uint64(1)<<free) != 0 {
+			continue
+		}
+		vector := uint64(1) << free
+		for i, col := range pivots {
+			if eqs[i].coef&(uint64(1)<<free) != 0 {
+				vector |= uint64(1) << col
+			}
+		}
+		nullspace = append(nullspace, vector)
+	}
+	return solution, nullspace, true
+}
@@ -0,0 +1,57 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"bytes"
+	"sync"
+)
+
+// Match describes a catalogue algorithm found by Identify to produce a given checksum.
+type Match struct {
+	Name       string      // Name of the algorithm as listed in CRC RevEng catalogue
+	Parameters *Parameters // Parameters of the algorithm, shared with the catalogue, so do not modify them
+	Order      ByteOrder   // Order the checksum is stored in, either BigEndian or LittleEndian
+}
+
+var (
+	identifyOnce   sync.Once
+	identifyTables []*Table // tables for every catalogue entry, in the same order
+)
+
+// Identify tries every algorithm from the catalogue (see Lookup) against data and returns those
+// producing crc, stored either in BigEndian or LittleEndian byte order. Only algorithms which store
+// CRC in exactly len(crc) bytes are considered, and unused bits of crc (if any) have to be zero.
+// For algorithms up to 8 bits wide both byte orders are the same, so only BigEndian is reported.
+// Matches are returned in catalogue order, i.e. sorted by width; nil is returned if nothing matches.
+//
+// Short messages often match several algorithms by chance, so make sure to check results against
+// another sample, or use a longer message.
+func Identify(data []byte, crc []byte) []Match {
+	identifyOnce.Do(func() {
+		identifyTables = make([]*Table, len(catalogue))
+		for i, entry := range catalogue {
+			identifyTables[i] = NewTable(entry.params)
+		}
+	})
+
+	var matches []Match
+	for i, entry := range catalogue {
+		width := entry.params.Width
+		if int(width+7)/8 != len(crc) {
+			continue
+		}
+		calculated := identifyTables[i].CalculateCRC(data)
+		for _, order := range []ByteOrder{BigEndian, LittleEndian} {
+			if order == LittleEndian && width <= 8 {
+				break
+			}
+			if bytes.Equal(order.Append(nil, calculated, width), crc) {
+				matches = append(matches, Match{entry.params.Name, entry.params, order})
+			}
+		}
+	}
+	return matches
+}
@@ -0,0 +1,50 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import "testing"
+
+func TestIdentify(t *testing.T) {
+	// every catalogue algorithm has to identify itself by its check value in both byte orders
+	for _, entry := range catalogue {
+		params := entry.params
+		for _, order := range []ByteOrder{BigEndian, LittleEndian} {
+			found := false
+			for _, match := range Identify(checkInput, order.Append(nil, params.Check, params.Width)) {
+				if match.Parameters == params && match.Name == params.Name && (match.Order == order || params.Width <= 8) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("%s not identified by its check value 0x%X stored as %v", params.Name, params.Check, order)
+			}
+		}
+	}
+
+	data := []byte("firmware image")
+	crc := LittleEndian.Append(nil, CalculateCRC(CRC16MODBUS, data), 16)
+	matches := Identify(data, crc)
+	if len(matches) != 1 || matches[0].Name != "CRC-16/MODBUS" || matches[0].Order != LittleEndian {
+		t.Errorf("Incorrect matches %v for CRC-16/MODBUS (should be a single match, little endian)", matches)
+	}
+
+	crc = BigEndian.Append(nil, CalculateCRC(CRC32, data), 32)
+	matches = Identify(data, crc)
+	if len(matches) != 1 || matches[0].Parameters != CRC32 || matches[0].Order != BigEndian {
+		t.Errorf("Incorrect matches %v for CRC-32 (should be a single match, big endian)", matches)
+	}
+
+	if matches := Identify(data, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}); matches != nil {
+		t.Errorf("Unexpected matches %v for 9 byte checksum", matches)
+	}
+	// unused bits must be zero
+	crc = BigEndian.Append(nil, CalculateCRC(CRC12UMTS, data), 12)
+	crc[0] |= 0x80
+	for _, match := range Identify(data, crc) {
+		if match.Parameters == CRC12UMTS {
+			t.Errorf("CRC-12/UMTS identified despite unused bits set")
+		}
+	}
+}
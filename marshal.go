@@ -0,0 +1,118 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Hash state is marshaled as a header (magic and version), followed by width, reflection flags,
+// byte order (since version 2), polynomial, init, final xor, check and residue values, current
+// register value and the name of the CRC algorithm. Fixed size values are big endian, name is
+// prefixed by its uvarint length.
+const (
+	marshalMagic   = "crc"
+	marshalVersion = 2
+	marshalSize    = len(marshalMagic) + 1 + 2 + 6*8 // of version 1 without name
+)
+
+var (
+	// ErrInvalidState is returned when unmarshaling data which is not a marshaled Hash state
+	ErrInvalidState = errors.New("crc: invalid hash state")
+	// ErrUnsupportedState is returned when unmarshaling Hash state of unsupported version
+	ErrUnsupportedState = errors.New("crc: unsupported hash state version")
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler interface. It saves current state of the Hash along with
+// its Parameters and byte order, so the calculation can be resumed later (e.g. after a restart) by UnmarshalBinary.
+func (h *Hash) MarshalBinary() ([]byte, error) {
+	params := &h.table.crcParams
+	b := make([]byte, 0, marshalSize+binary.MaxVarintLen64+len(params.Name))
+	b = append(b, marshalMagic...)
+	b = append(b, marshalVersion)
+	b = append(b, byte(params.Width))
+	var flags byte
+	if params.ReflectIn {
+		flags |= 1
+	}
+	if params.ReflectOut {
+		flags |= 2
+	}
+	b = append(b, flags, byte(h.order))
+	for _, v := range []uint64{params.Polynomial, params.Init, params.FinalXor, params.Check, params.Residue, h.curValue & h.table.mask} {
+		b = binary.BigEndian.AppendUint64(b, v)
+	}
+	b = binary.AppendUvarint(b, uint64(len(params.Name)))
+	b = append(b, params.Name...)
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler interface. It restores Hash state previously saved
+// by MarshalBinary, including Parameters. Hash does not need to be initialized beforehand, so a zero Hash
+// value may be used. The table is reused if Hash already has one for the same Parameters.
+func (h *Hash) UnmarshalBinary(b []byte) error {
+	if len(b) < marshalSize || string(b[:len(marshalMagic)]) != marshalMagic {
+		return ErrInvalidState
+	}
+	b = b[len(marshalMagic):]
+	version := b[0]
+	if version < 1 || version > marshalVersion {
+		return ErrUnsupportedState
+	}
+
+	params := Parameters{
+		Width:      uint(b[1]),
+		ReflectIn:  b[2]&1 != 0,
+		ReflectOut: b[2]&2 != 0,
+	}
+	b = b[3:]
+	order := BigEndian
+	if version >= 2 {
+		if len(b) < 6*8+1 {
+			return ErrInvalidState
+		}
+		order = ByteOrder(b[0])
+		if order < BigEndian || order > BitReversed {
+			return ErrInvalidState
+		}
+		b = b[1:]
+	}
+	var values [6]uint64
+	for i := range values {
+		values[i] = binary.BigEndian.Uint64(b)
+		b = b[8:]
+	}
+	params.Polynomial, params.Init, params.FinalXor, params.Check, params.Residue = values[0], values[1], values[2], values[3], values[4]
+	nameLen, n := binary.Uvarint(b)
+	if n <= 0 || uint64(len(b)-n) != nameLen {
+		return ErrInvalidState
+	}
+	params.Name = string(b[n:])
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if h.table == nil || h.table.crcParams != params {
+		h.table = NewTable(&params)
+	}
+	h.size = (params.Width + 7) / 8
+	h.curValue = values[5] & h.table.mask
+	h.order = order
+	return nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler interface, see Hash.UnmarshalBinary.
+// It refuses to restore state of CRC algorithms wider than 32 bits.
+func (h *Hash32) UnmarshalBinary(b []byte) error {
+	restored := h.Hash
+	if err := restored.UnmarshalBinary(b); err != nil {
+		return err
+	}
+	if width := restored.table.crcParams.Width; width > 32 {
+		return &ParametersError{"Width", uint64(width), ErrWidthOutOfRange}
+	}
+	h.Hash = restored
+	return nil
+}
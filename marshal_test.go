@@ -0,0 +1,99 @@
+package crc
+
+import (
+	"encoding"
+	"errors"
+	"testing"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	data := []byte("Whenever digital data is stored or interfaced, data corruption might occur.")
+
+	doTest := func(params *Parameters) {
+		h := NewHash(params)
+		h.Update(data[:30])
+		state, err := h.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Unexpected error marshaling %s: %v", params.Name, err)
+		}
+
+		var restored Hash
+		if err := restored.UnmarshalBinary(state); err != nil {
+			t.Fatalf("Unexpected error unmarshaling %s: %v", params.Name, err)
+		}
+		if restored.table.crcParams != *params {
+			t.Errorf("Parameters %+v restored when should be %+v", restored.table.crcParams, *params)
+		}
+		restored.Update(data[30:])
+		expected := CalculateCRC(params, data)
+		if calculated := restored.CRC(); calculated != expected {
+			t.Errorf("Incorrect CRC 0x%04x calculated for %s after unmarshaling (should be 0x%04x)", calculated, params.Name, expected)
+		}
+		if restored.Size() != h.Size() {
+			t.Errorf("Incorrect size %d restored for %s (should be %d)", restored.Size(), params.Name, h.Size())
+		}
+
+		// table is reused when unmarshaling into a Hash for the same parameters
+		table := h.Table()
+		if err := h.UnmarshalBinary(state); err != nil {
+			t.Fatalf("Unexpected error unmarshaling %s: %v", params.Name, err)
+		}
+		if h.Table() != table {
+			t.Errorf("Table was not reused when unmarshaling %s", params.Name)
+		}
+	}
+
+	for _, entry := range catalogue {
+		doTest(entry.params)
+	}
+	doTest(&Parameters{Width: 7, Polynomial: 0x09, ReflectIn: true})
+}
+
+func TestMarshalBinaryHash32(t *testing.T) {
+	h, _ := NewHash32(CRC32C)
+	var _ encoding.BinaryMarshaler = h
+	var _ encoding.BinaryUnmarshaler = h
+
+	h.Write([]byte("1234"))
+	state, _ := h.MarshalBinary()
+	restored := &Hash32{}
+	if err := restored.UnmarshalBinary(state); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	restored.Write([]byte("56789"))
+	if sum := restored.Sum32(); sum != 0xE3069283 {
+		t.Errorf("Incorrect Sum32 0x%08x calculated after unmarshaling (should be 0xE3069283)", sum)
+	}
+
+	state, _ = NewHash(CRC64XZ).MarshalBinary()
+	if err := restored.UnmarshalBinary(state); !errors.Is(err, ErrWidthOutOfRange) {
+		t.Errorf("Hash32 accepted state of 64 bit wide CRC, got %v", err)
+	}
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	state, _ := NewHash(CRC16MODBUS).MarshalBinary()
+	var h Hash
+
+	if err := h.UnmarshalBinary(state[:len(state)-1]); err != ErrInvalidState {
+		t.Errorf("Truncated state was not rejected, got %v", err)
+	}
+	if err := h.UnmarshalBinary(append(state, 0)); err != ErrInvalidState {
+		t.Errorf("State with trailing garbage was not rejected, got %v", err)
+	}
+	if err := h.UnmarshalBinary([]byte("not a state")); err != ErrInvalidState {
+		t.Errorf("Garbage was not rejected, got %v", err)
+	}
+
+	unsupported := append([]byte(nil), state...)
+	unsupported[3] = 99
+	if err := h.UnmarshalBinary(unsupported); err != ErrUnsupportedState {
+		t.Errorf("State of unsupported version was not rejected, got %v", err)
+	}
+
+	invalid := append([]byte(nil), state...)
+	invalid[4] = 65 // width
+	if err := h.UnmarshalBinary(invalid); !errors.Is(err, ErrWidthOutOfRange) {
+		t.Errorf("State with invalid parameters was not rejected, got %v", err)
+	}
+}
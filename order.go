@@ -0,0 +1,78 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import "strconv"
+
+// ByteOrder specifies how a CRC value is stored in bytes, e.g. by Hash.Sum.
+// CRC always occupies the smallest number of bytes enough to store it, i.e. (Width+7)/8.
+type ByteOrder int
+
+const (
+	// BigEndian stores the most significant byte first. CRC is right aligned, i.e. if width is not
+	// a multiple of 8, unused bits are the most significant bits of the first byte. It is the default.
+	BigEndian ByteOrder = iota
+	// LittleEndian stores the least significant byte first, as Modbus, USB, Ethernet FCS and many file formats do.
+	// CRC is right aligned, i.e. if width is not a multiple of 8, unused bits are the most significant bits of the last byte.
+	LittleEndian
+	// BitReversed stores CRC with the order of all its bits reversed, so the least significant bit of CRC becomes
+	// the most significant bit of the first byte. CRC is left aligned, i.e. if width is not a multiple of 8,
+	// unused bits are the least significant bits of the last byte.
+	BitReversed
+)
+
+// String returns the name of the byte order
+func (o ByteOrder) String() string {
+	switch o {
+	case BigEndian:
+		return "BigEndian"
+	case LittleEndian:
+		return "LittleEndian"
+	case BitReversed:
+		return "BitReversed"
+	}
+	return "ByteOrder(" + strconv.Itoa(int(o)) + ")"
+}
+
+// Append appends crc of a given width to dst and returns the resulting slice.
+func (o ByteOrder) Append(dst []byte, crc uint64, width uint) []byte {
+	size := (width + 7) / 8
+	crc &= widthMask(width)
+	switch o {
+	case LittleEndian:
+		for i := uint(0); i < size; i++ {
+			dst = append(dst, byte(crc>>(8*i)))
+		}
+		return dst
+	case BitReversed:
+		crc = reflect(crc, width) << (8*size - width)
+	}
+	for i := size; i > 0; {
+		i--
+		dst = append(dst, byte(crc>>(8*i)))
+	}
+	return dst
+}
+
+// Decode reads crc of a given width stored by Append from b, which must be at least (width+7)/8 bytes long.
+// Unused bits (if any) are ignored.
+func (o ByteOrder) Decode(b []byte, width uint) uint64 {
+	size := (width + 7) / 8
+	_ = b[size-1] // early bounds check
+	var crc uint64
+	if o == LittleEndian {
+		for i := uint(0); i < size; i++ {
+			crc |= uint64(b[i]) << (8 * i)
+		}
+		return crc & widthMask(width)
+	}
+	for i := uint(0); i < size; i++ {
+		crc = crc<<8 | uint64(b[i])
+	}
+	if o == BitReversed {
+		return reflect(crc>>(8*size-width), width) & widthMask(width)
+	}
+	return crc & widthMask(width)
+}
@@ -0,0 +1,94 @@
+package crc
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestByteOrder(t *testing.T) {
+	doTest := func(order ByteOrder, width uint, crc uint64, expected []byte) {
+		encoded := order.Append([]byte{0xAA}, crc, width)
+		if !bytes.Equal(encoded[1:], expected) || encoded[0] != 0xAA {
+			t.Errorf("%v encoded %d bit wide 0x%x as % x when should be % x", order, width, crc, encoded[1:], expected)
+		}
+		if decoded := order.Decode(expected, width); decoded != crc {
+			t.Errorf("%v decoded % x as 0x%x when should be 0x%x", order, expected, decoded, crc)
+		}
+	}
+
+	doTest(BigEndian, 16, 0x4B37, []byte{0x4B, 0x37})
+	doTest(LittleEndian, 16, 0x4B37, []byte{0x37, 0x4B})
+	doTest(BitReversed, 16, 0x4B37, []byte{0xEC, 0xD2})
+	doTest(BigEndian, 5, 0x19, []byte{0x19})
+	doTest(LittleEndian, 5, 0x19, []byte{0x19})
+	doTest(BitReversed, 5, 0x19, []byte{0x98})
+	doTest(BigEndian, 12, 0xDAF, []byte{0x0D, 0xAF})
+	doTest(LittleEndian, 12, 0xDAF, []byte{0xAF, 0x0D})
+	doTest(BitReversed, 12, 0xDAF, []byte{0xF5, 0xB0})
+	doTest(BigEndian, 64, 0x995DC9BBDF1939FA, []byte{0x99, 0x5D, 0xC9, 0xBB, 0xDF, 0x19, 0x39, 0xFA})
+	doTest(LittleEndian, 64, 0x995DC9BBDF1939FA, []byte{0xFA, 0x39, 0x19, 0xDF, 0xBB, 0xC9, 0x5D, 0x99})
+
+	// unused bits are ignored by Decode
+	if decoded := BigEndian.Decode([]byte{0xFF}, 5); decoded != 0x1F {
+		t.Errorf("BigEndian decoded 0x%x when should be 0x1f", decoded)
+	}
+	if decoded := BitReversed.Decode([]byte{0x9F}, 5); decoded != 0x19 {
+		t.Errorf("BitReversed decoded 0x%x when should be 0x19", decoded)
+	}
+
+	// round trip for all widths
+	for width := uint(1); width <= 64; width++ {
+		crc := uint64(0xA5C3F00F5A3C0FF0) & widthMask(width)
+		for _, order := range []ByteOrder{BigEndian, LittleEndian, BitReversed} {
+			encoded := order.Append(nil, crc, width)
+			if len(encoded) != int(width+7)/8 {
+				t.Errorf("%v encoded %d bit wide CRC into %d bytes", order, width, len(encoded))
+			}
+			if decoded := order.Decode(encoded, width); decoded != crc {
+				t.Errorf("%v decoded %d bit wide 0x%x as 0x%x", order, width, crc, decoded)
+			}
+		}
+	}
+}
+
+func TestHashByteOrder(t *testing.T) {
+	h := NewHash(CRC16MODBUS)
+	h.Write([]byte("123456789"))
+	if sum := h.Sum(nil); !bytes.Equal(sum, []byte{0x4B, 0x37}) {
+		t.Errorf("Sum returned % x by default when should return 4b 37", sum)
+	}
+	h.SetByteOrder(LittleEndian)
+	if h.ByteOrder() != LittleEndian {
+		t.Errorf("Byte order %v set when should be LittleEndian", h.ByteOrder())
+	}
+	sum := h.Sum(nil)
+	if !bytes.Equal(sum, []byte{0x37, 0x4B}) {
+		t.Errorf("Sum returned % x when should return 37 4b", sum)
+	}
+	if decoded := h.Decode(sum); decoded != 0x4B37 {
+		t.Errorf("Decode returned 0x%04x when should return 0x4b37", decoded)
+	}
+	if appended := h.Append([]byte{1}, 0x1234); !bytes.Equal(appended, []byte{1, 0x34, 0x12}) {
+		t.Errorf("Append returned % x when should return 01 34 12", appended)
+	}
+
+	// byte order survives marshaling
+	state, _ := h.MarshalBinary()
+	var restored Hash
+	if err := restored.UnmarshalBinary(state); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if restored.ByteOrder() != LittleEndian || !bytes.Equal(restored.Sum(nil), sum) {
+		t.Errorf("Byte order %v restored when should be LittleEndian", restored.ByteOrder())
+	}
+
+	// state saved by version 1 has no byte order
+	v1 := append([]byte("crc\x01"), state[4:6]...)
+	v1 = append(v1, state[7:]...)
+	if err := restored.UnmarshalBinary(v1); err != nil {
+		t.Fatalf("Unexpected error unmarshaling version 1 state: %v", err)
+	}
+	if restored.ByteOrder() != BigEndian || restored.CRC() != 0x4B37 {
+		t.Errorf("Version 1 state restored as %v 0x%04x when should be BigEndian 0x4b37", restored.ByteOrder(), restored.CRC())
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"errors"
+	"io"
+	"runtime"
+	"sync"
+)
+
+// parallelMinChunk is the smallest number of bytes worth processing in a separate goroutine
+const parallelMinChunk = 64 * 1024
+
+// parallelReadSize is the size of buffer each goroutine uses to read its chunk from io.ReaderAt
+const parallelReadSize = 1024 * 1024
+
+// CalculateCRCParallel is same as CalculateCRC, except it splits data into chunks, calculates their CRCs
+// in up to workers goroutines concurrently and then merges them using Combine. If workers is not positive,
+// runtime.GOMAXPROCS(0) goroutines are used. Small data is processed in the calling goroutine.
+func (t *Table) CalculateCRCParallel(data []byte, workers int) uint64 {
+	chunks := splitChunks(int64(len(data)), workers)
+	if len(chunks) == 1 {
+		return t.CalculateCRC(data)
+	}
+	crcs := make([]uint64, len(chunks))
+	var wg sync.WaitGroup
+	for i := range chunks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			crcs[i] = t.CalculateCRC(data[chunks[i].start:chunks[i].end])
+		}(i)
+	}
+	wg.Wait()
+	return t.combineChunks(chunks, crcs)
+}
+
+// ErrNegativeSize means CalculateCRCReaderAt was asked to process negative number of bytes
+var ErrNegativeSize = errors.New("crc: negative size")
+
+// CalculateCRCReaderAt calculates CRC of size bytes available from r, reading and processing them
+// in up to workers goroutines concurrently, same way as CalculateCRCParallel does.
+// It returns the first error encountered while reading, if any, or ErrNegativeSize if size is negative.
+func (t *Table) CalculateCRCReaderAt(r io.ReaderAt, size int64, workers int) (uint64, error) {
+	if size < 0 {
+		return 0, ErrNegativeSize
+	}
+	chunks := splitChunks(size, workers)
+	if len(chunks) == 1 {
+		return t.calculateRange(r, 0, size)
+	}
+	crcs := make([]uint64, len(chunks))
+	errs := make([]error, len(chunks))
+	var wg sync.WaitGroup
+	for i := range chunks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			crcs[i], errs[i] = t.calculateRange(r, chunks[i].start, chunks[i].end)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return 0, err
+		}
+	}
+	return t.combineChunks(chunks, crcs), nil
+}
+
+// calculateRange calculates CRC of bytes from start (inclusive) to end (exclusive) read from r
+func (t *Table) calculateRange(r io.ReaderAt, start, end int64) (uint64, error) {
+	crc, err := t.updateRange(t.InitCrc(), r, start, end)
+	if err != nil {
+		return 0, err
+	}
+	return t.CRC(crc), nil
+}
+
+// updateRange updates current (partial) CRC with bytes from start (inclusive) to end (exclusive) read from r
+func (t *Table) updateRange(crc uint64, r io.ReaderAt, start, end int64) (uint64, error) {
+	bufSize := int64(parallelReadSize)
+	if end-start < bufSize {
+		bufSize = end - start
+	}
+	buf := make([]byte, bufSize)
+	for start < end {
+		p := buf
+		if end-start < int64(len(p)) {
+			p = p[:end-start]
+		}
+		n, err := r.ReadAt(p, start)
+		if n < len(p) {
+			if err == nil || err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		crc = t.UpdateCrc(crc, p)
+		start += int64(n)
+	}
+	return crc, nil
+}
+
+// chunk is a range of data processed by a single goroutine
+type chunk struct {
+	start, end int64
+}
+
+// splitChunks splits size bytes into chunks of (almost) equal size for up to workers goroutines,
+// making sure no chunk is smaller than parallelMinChunk. It always returns at least one chunk.
+func splitChunks(size int64, workers int) []chunk {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	count := size / parallelMinChunk
+	if count > int64(workers) {
+		count = int64(workers)
+	}
+	if count < 1 {
+		count = 1
+	}
+	chunks := make([]chunk, count)
+	for i := range chunks {
+		chunks[i].start = size * int64(i) / count
+		chunks[i].end = size * int64(i+1) / count
+	}
+	return chunks
+}
+
+// combineChunks merges CRCs calculated for consecutive chunks into CRC of all data
+func (t *Table) combineChunks(chunks []chunk, crcs []uint64) uint64 {
+	crc := crcs[0]
+	for i := 1; i < len(chunks); i++ {
+		crc = t.Combine(crc, crcs[i], chunks[i].end-chunks[i].start)
+	}
+	return crc
+}
@@ -0,0 +1,62 @@
+package crc
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+func TestCalculateCRCParallel(t *testing.T) {
+	data := make([]byte, 5*parallelMinChunk+123)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	for _, params := range []*Parameters{CRC64XZ, CRC32, CRC32MPEG2, CCITT, CRC5USB, CRC12UMTS} {
+		table := NewTable(params)
+		for _, size := range []int{0, 10, parallelMinChunk, 2*parallelMinChunk + 1, len(data)} {
+			expected := table.CalculateCRC(data[:size])
+			for _, workers := range []int{-1, 0, 1, 2, 3, 8} {
+				if calculated := table.CalculateCRCParallel(data[:size], workers); calculated != expected {
+					t.Errorf("Incorrect CRC 0x%04x calculated in parallel for %s over %d bytes with %d workers (should be 0x%04x)", calculated, params.Name, size, workers, expected)
+				}
+				calculated, err := table.CalculateCRCReaderAt(bytes.NewReader(data[:size]), int64(size), workers)
+				if err != nil {
+					t.Errorf("Unexpected error reading %d bytes: %v", size, err)
+				} else if calculated != expected {
+					t.Errorf("Incorrect CRC 0x%04x calculated from ReaderAt for %s over %d bytes with %d workers (should be 0x%04x)", calculated, params.Name, size, workers, expected)
+				}
+			}
+		}
+	}
+}
+
+type failingReaderAt struct{}
+
+func (failingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestCalculateCRCReaderAtErrors(t *testing.T) {
+	table := NewTable(CRC64XZ)
+	if _, err := table.CalculateCRCReaderAt(failingReaderAt{}, 3*parallelMinChunk, 4); err == nil || err.Error() != "read failed" {
+		t.Errorf("Read error was not reported, got %v", err)
+	}
+	// reader shorter than size requested
+	data := make([]byte, 3*parallelMinChunk)
+	if _, err := table.CalculateCRCReaderAt(bytes.NewReader(data), int64(len(data))+1, 4); err != io.ErrUnexpectedEOF {
+		t.Errorf("Short read was not reported, got %v", err)
+	}
+	if _, err := table.CalculateCRCReaderAt(bytes.NewReader(data), -1, 4); err != ErrNegativeSize {
+		t.Errorf("Unexpected error %v for negative size (should be ErrNegativeSize)", err)
+	}
+}
+
+func BenchmarkCalculateCRCParallel(b *testing.B) {
+	table := NewTable(CRC64XZ)
+	data := make([]byte, 64*1024*1024)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		table.CalculateCRCParallel(data, 0)
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+// UpdateAfterPatch returns CRC of data after oldBytes at offset have been overwritten with newBytes,
+// given oldCRC of data before the change and totalLen of data in bytes. oldBytes and newBytes must be
+// of the same length, and the patch must lie within data, otherwise UpdateAfterPatch panics.
+// oldCRC must be a final value (as returned by CRC or CalculateCRC) calculated with this Table.
+//
+// By linearity of CRC, the register changes by the register value after processing oldBytes^newBytes from zero,
+// advanced over the rest of data, so it takes O(len(newBytes) + log(totalLen)) time, regardless of Init and FinalXor.
+func (t *Table) UpdateAfterPatch(oldCRC uint64, totalLen int64, offset int64, oldBytes, newBytes []byte) uint64 {
+	if len(oldBytes) != len(newBytes) {
+		panic("crc: UpdateAfterPatch called with oldBytes and newBytes of different lengths")
+	}
+	if offset < 0 || offset > totalLen-int64(len(newBytes)) {
+		panic("crc: UpdateAfterPatch called with patch out of range of data")
+	}
+	diff := make([]byte, len(newBytes))
+	for i := range diff {
+		diff[i] = oldBytes[i] ^ newBytes[i]
+	}
+	tail := totalLen - offset - int64(len(diff))
+	change := t.shiftState(t.UpdateCrc(0, diff), uint64(tail))
+	return t.CRC(t.StateFromCRC(oldCRC) ^ change)
+}
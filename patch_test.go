@@ -0,0 +1,41 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import "testing"
+
+func TestUpdateAfterPatch(t *testing.T) {
+	data := make([]byte, 4096)
+	for i := range data {
+		data[i] = byte(i*13 + 5)
+	}
+	newBytes := []byte("new header field value")
+
+	for _, params := range []*Parameters{CRC32, CCITT, X25, CRC64XZ, CRC12UMTS, CRC5USB, CRC3GSM} {
+		table := NewTable(params)
+		oldCRC := table.CalculateCRC(data)
+		for _, offset := range []int{0, 1, 100, 2048, len(data) - len(newBytes)} {
+			patched := append([]byte(nil), data...)
+			copy(patched[offset:], newBytes)
+			expected := table.CalculateCRC(patched)
+			crc := table.UpdateAfterPatch(oldCRC, int64(len(data)), int64(offset), data[offset:offset+len(newBytes)], newBytes)
+			if crc != expected {
+				t.Errorf("Incorrect %s CRC 0x%X after patch at offset %d (should be 0x%X)", params.Name, crc, offset, expected)
+			}
+		}
+	}
+
+	table := NewTable(CRC32)
+	for _, patch := range []struct{ totalLen, offset int64 }{{100, -1}, {100, 90}, {-5, 0}, {10, 0}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("UpdateAfterPatch did not panic for %d bytes at offset %d of %d", len(newBytes), patch.offset, patch.totalLen)
+				}
+			}()
+			table.UpdateAfterPatch(0, patch.totalLen, patch.offset, newBytes, newBytes)
+		}()
+	}
+}
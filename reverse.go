@@ -0,0 +1,318 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"errors"
+	"math/big"
+)
+
+// Sample is a message along with its CRC, e.g. captured from a device using an unknown CRC algorithm.
+type Sample struct {
+	Data []byte // Data is the message CRC is calculated over
+	CRC  uint64 // CRC is the value calculated by the unknown algorithm
+}
+
+// ReverseOptions specifies what is already known about the CRC algorithm being recovered by Reverse.
+type ReverseOptions struct {
+	Width      uint   // Width of the CRC, which is always required
+	Polynomial uint64 // Polynomial if it is known, or 0 to search for it
+	Init       uint64 // Init assumed if samples cannot tell it apart from FinalXor, see Reverse
+}
+
+// Errors reported by Reverse
+var (
+	// ErrNotEnoughSamples means there are not enough samples to search for the polynomial.
+	// At least two different samples of the same length are required for that.
+	ErrNotEnoughSamples = errors.New("crc: not enough samples of the same length")
+	// ErrAmbiguousPolynomial means samples do not narrow polynomial search down enough.
+	// More samples of the same length are likely to help.
+	ErrAmbiguousPolynomial = errors.New("crc: samples do not determine polynomial")
+	// ErrAmbiguousInit means samples leave too many possible Init values to try them one by one.
+	// Samples with more different lengths are likely to help.
+	ErrAmbiguousInit = errors.New("crc: samples do not determine init")
+)
+
+// reverseSearchWidth is the highest degree of polynomials Reverse tries one by one. If GCD of codeword
+// differences is not the polynomial itself, either all polynomials of given width or all cofactors
+// of degree of GCD less width (whichever is smaller) are tried to find those dividing GCD.
+const reverseSearchWidth = 16
+
+// reverseMaxFreeInitBits is the highest number of Init bits left undetermined by samples for which Reverse
+// still tries every Init value.
+const reverseMaxFreeInitBits = 8
+
+// Reverse recovers CRC algorithm parameters from samples of messages and their CRCs, much like CRC RevEng
+// does in its search mode. It returns all parameter sets consistent with every sample. Returned parameters
+// have Check and Residue filled in, as well as Name if they match an algorithm from the catalogue.
+//
+// Rather than trying every possible polynomial, Reverse relies on the fact that a message followed by its CRC
+// (a codeword) is congruent to Init*x^n + FinalXor modulo the polynomial, where n is the message length in bits.
+// So the polynomial divides the difference of any two codewords of the same length and can be found as a factor
+// of their GCD. Init is then found from samples of different lengths by solving a system of linear equations.
+// If all samples have the same length, Init and FinalXor cannot be told apart, so Init from opts is assumed.
+func Reverse(samples []Sample, opts ReverseOptions) ([]*Parameters, error) {
+	if opts.Width < 1 || opts.Width > 64 {
+		return nil, &ParametersError{"Width", uint64(opts.Width), ErrWidthOutOfRange}
+	}
+	if len(samples) == 0 {
+		return nil, ErrNotEnoughSamples
+	}
+
+	var results []*Parameters
+	var searchErr error
+	for _, reflection := range [][2]bool{{true, true}, {false, false}, {false, true}, {true, false}} {
+		r := reverser{samples: samples, opts: opts, reflectIn: reflection[0], reflectOut: reflection[1]}
+		candidates := []uint64{opts.Polynomial}
+		if opts.Polynomial == 0 {
+			var err error
+			if candidates, err = r.polynomials(); err != nil {
+				searchErr = err
+				continue
+			}
+		}
+		for _, poly := range candidates {
+			found, err := r.solve(poly)
+			if err != nil {
+				searchErr = err
+			}
+			results = append(results, found...)
+		}
+	}
+	if len(results) == 0 && searchErr != nil {
+		return nil, searchErr
+	}
+	return results, nil
+}
+
+// reverser recovers CRC parameters for one combination of input and output reflection
+type reverser struct {
+	samples    []Sample
+	opts       ReverseOptions
+	reflectIn  bool
+	reflectOut bool
+}
+
+// codeword returns sample's message followed by its CRC as a polynomial, with bits in the order they enter
+// the register. For the sake of simplicity FinalXor is considered a part of CRC.
+func (r *reverser) codeword(sample Sample) *big.Int {
+	message := make([]byte, len(sample.Data))
+	for i, b := range sample.Data {
+		if r.reflectIn {
+			b = byte(reflect(uint64(b), 8))
+		}
+		message[i] = b
+	}
+	crc := sample.CRC & widthMask(r.opts.Width)
+	if r.reflectOut {
+		crc = reflect(crc, r.opts.Width)
+	}
+	ret := new(big.Int).SetBytes(message)
+	ret.Lsh(ret, r.opts.Width)
+	return ret.Or(ret, new(big.Int).SetUint64(crc))
+}
+
+// polynomials returns polynomials (without the top x^width term) dividing all differences between codewords
+// of the same length, i.e. the candidates for polynomial used.
+func (r *reverser) polynomials() ([]uint64, error) {
+	width := r.opts.Width
+	first := make(map[int]*big.Int) // first codeword of each length
+	gcd := new(big.Int)
+	for _, sample := range r.samples {
+		codeword := r.codeword(sample)
+		if prev, ok := first[len(sample.Data)]; ok {
+			gcd = gf2GCD(gcd, new(big.Int).Xor(prev, codeword))
+		} else {
+			first[len(sample.Data)] = codeword
+		}
+	}
+	if gcd.Sign() == 0 {
+		return nil, ErrNotEnoughSamples
+	}
+	// polynomial is odd, so it can't have x as a factor
+	gcd.Rsh(gcd, gcd.TrailingZeroBits())
+
+	degree := uint(gcd.BitLen() - 1)
+	if degree < width {
+		return nil, nil
+	}
+	cofactorDegree := degree - width
+	if cofactorDegree == 0 {
+		return []uint64{new(big.Int).SetBit(gcd, int(width), 0).Uint64()}, nil
+	}
+
+	var candidates []uint64
+	switch {
+	case width <= reverseSearchWidth && width <= cofactorDegree:
+		for poly := uint64(1); poly < uint64(1)<<width; poly += 2 {
+			if gf2ModPoly(gcd, poly, width) == 0 {
+				candidates = append(candidates, poly)
+			}
+		}
+	case cofactorDegree <= reverseSearchWidth:
+		for cofactor := uint64(1); cofactor < uint64(1)<<cofactorDegree; cofactor += 2 {
+			if gf2ModPoly(gcd, cofactor, cofactorDegree) == 0 {
+				divisor := new(big.Int).SetUint64(cofactor)
+				poly := gf2Div(gcd, divisor.SetBit(divisor, int(cofactorDegree), 1))
+				candidates = append(candidates, poly.SetBit(poly, int(width), 0).Uint64())
+			}
+		}
+	default:
+		return nil, ErrAmbiguousPolynomial
+	}
+	return candidates, nil
+}
+
+// solve finds Init and FinalXor for a given polynomial and returns parameter sets consistent with all samples.
+// It reports ErrAmbiguousInit if there are more than 2^reverseMaxFreeInitBits Init values to try.
+func (r *reverser) solve(poly uint64) ([]*Parameters, error) {
+	width := r.opts.Width
+	if poly&1 == 0 || poly&^widthMask(width) != 0 {
+		return nil, nil
+	}
+	// codeword mod P equals Init*x^n + FinalXor, where n is length of message in bits
+	remainders := make([]uint64, len(r.samples))
+	for i, sample := range r.samples {
+		remainders[i] = gf2ModPoly(r.codeword(sample), poly, width)
+	}
+	xn := func(sample Sample) uint64 {
+		return xPowMod(8*uint64(len(sample.Data)), poly, width)
+	}
+
+	// every pair of samples of different lengths gives width equations:
+	// remainder(a) + remainder(b) = Init*(x^n(a) + x^n(b))
+	var equations []gf2Equation
+	ref := r.samples[0]
+	for i, sample := range r.samples[1:] {
+		if len(sample.Data) == len(ref.Data) {
+			continue
+		}
+		factor := xn(ref) ^ xn(sample)
+		var columns [64]uint64
+		column := factor
+		for j := uint(0); j < width; j++ {
+			columns[j] = column
+			column = mulXMod(column, poly, width)
+		}
+		rhs := remainders[0] ^ remainders[i+1]
+		for row := uint(0); row < width; row++ {
+			var coef uint64
+			for j := uint(0); j < width; j++ {
+				coef |= ((columns[j] >> row) & 1) << j
+			}
+			equations = append(equations, gf2Equation{coef, rhs >> row})
+		}
+	}
+
+	inits := []uint64{r.opts.Init & widthMask(width)}
+	if len(equations) > 0 {
+		solution, nullspace, ok := solveGF2(equations, width)
+		if !ok {
+			return nil, nil
+		}
+		if len(nullspace) > reverseMaxFreeInitBits {
+			return nil, ErrAmbiguousInit
+		}
+		inits = inits[:0]
+		for combination := 0; combination < 1<<uint(len(nullspace)); combination++ {
+			init := solution
+			for k, vector := range nullspace {
+				if combination&(1<<uint(k)) != 0 {
+					init ^= vector
+				}
+			}
+			inits = append(inits, init)
+		}
+	}
+
+	var results []*Parameters
+	for _, init := range inits {
+		finalXor := remainders[0] ^ mulMod(init, xn(ref), poly, width)
+		if r.reflectOut {
+			finalXor = reflect(finalXor, width)
+		}
+		params := &Parameters{
+			Width:      width,
+			Polynomial: poly,
+			Init:       init,
+			ReflectIn:  r.reflectIn,
+			ReflectOut: r.reflectOut,
+			FinalXor:   finalXor,
+		}
+		if r.matchesAll(params) {
+			completeParameters(params)
+			results = append(results, params)
+		}
+	}
+	return results, nil
+}
+
+// matchesAll checks whether params reproduce CRC of every sample
+func (r *reverser) matchesAll(params *Parameters) bool {
+	table := newOneOffTable(params)
+	for _, sample := range r.samples {
+		if table.CalculateCRC(sample.Data) != sample.CRC&widthMask(params.Width) {
+			return false
+		}
+	}
+	return true
+}
+
+// completeParameters fills in Check and Residue of params, as well as the Name if the same algorithm
+// can be found in the catalogue.
+func completeParameters(params *Parameters) {
+	params.Check = CalculateCRC(params, checkInput)
+	params.Residue = CalculateCRC(residueRun(params))
+	for _, entry := range catalogue {
+		known := *entry.params
+		known.Name = ""
+		if known == *params {
+			params.Name = entry.params.Name
+			return
+		}
+	}
+}
+
+// gf2GCD returns the greatest common divisor of polynomials a and b over GF(2)
+func gf2GCD(a, b *big.Int) *big.Int {
+	a, b = new(big.Int).Set(a), new(big.Int).Set(b)
+	for b.Sign() != 0 {
+		a, b = b, gf2Mod(a, b)
+	}
+	return a
+}
+
+// gf2Mod returns remainder of division of polynomial a by m over GF(2). It modifies a.
+func gf2Mod(a, m *big.Int) *big.Int {
+	degree := m.BitLen() - 1
+	shifted := new(big.Int)
+	for a.BitLen()-1 >= degree {
+		a.Xor(a, shifted.Lsh(m, uint(a.BitLen()-1-degree)))
+	}
+	return a
+}
+
+// gf2Div returns quotient of division of polynomial a by m over GF(2), ignoring the remainder.
+func gf2Div(a, m *big.Int) *big.Int {
+	a = new(big.Int).Set(a)
+	quotient := new(big.Int)
+	degree := m.BitLen() - 1
+	shifted := new(big.Int)
+	for a.BitLen()-1 >= degree {
+		shift := a.BitLen() - 1 - degree
+		quotient.SetBit(quotient, shift, 1)
+		a.Xor(a, shifted.Lsh(m, uint(shift)))
+	}
+	return quotient
+}
+
+// gf2ModPoly returns remainder of division of polynomial a by x^width + poly over GF(2)
+func gf2ModPoly(a *big.Int, poly uint64, width uint) uint64 {
+	var ret uint64
+	for i := a.BitLen() - 1; i >= 0; i-- {
+		ret = mulXMod(ret, poly, width) ^ uint64(a.Bit(i))
+	}
+	return ret
+}
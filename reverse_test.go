@@ -0,0 +1,77 @@
+package crc
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestReverse(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	makeSamples := func(params *Parameters, lengths ...int) []Sample {
+		samples := make([]Sample, len(lengths))
+		for i, length := range lengths {
+			data := make([]byte, length)
+			rnd.Read(data)
+			samples[i] = Sample{data, CalculateCRC(params, data)}
+		}
+		return samples
+	}
+
+	doTest := func(params *Parameters, opts ReverseOptions, lengths ...int) {
+		opts.Width = params.Width
+		results, err := Reverse(makeSamples(params, lengths...), opts)
+		if err != nil {
+			t.Errorf("Unexpected error reversing %s: %v", params.Name, err)
+			return
+		}
+		found := false
+		for _, result := range results {
+			if *result == *params {
+				found = true
+			}
+			if err := result.SelfTest(); err != nil {
+				t.Errorf("Parameters %+v found for %s do not pass self test: %v", *result, params.Name, err)
+			}
+		}
+		if !found {
+			t.Errorf("Parameters of %s not found among %d results", params.Name, len(results))
+			for _, result := range results {
+				t.Logf("  %+v", *result)
+			}
+		}
+	}
+
+	doTest(CRC16MODBUS, ReverseOptions{}, 10, 10, 10, 13)
+	doTest(CRC32BZIP2, ReverseOptions{}, 20, 20, 20, 25)
+	doTest(CRC32, ReverseOptions{}, 16, 16, 16, 7, 8)
+	doTest(CRC64XZ, ReverseOptions{}, 32, 32, 32, 40)
+	doTest(CRC12UMTS, ReverseOptions{}, 6, 6, 6, 9)
+	doTest(CRC8SAEJ1850, ReverseOptions{}, 5, 5, 5, 3)
+	doTest(CRC5USB, ReverseOptions{}, 4, 4, 4, 4, 2)
+	doTest(CRC15MPT1327, ReverseOptions{}, 8, 8, 8, 11)
+
+	// all samples of the same length: init has to be known
+	doTest(CRC16IBM3740, ReverseOptions{Init: 0xFFFF}, 12, 12, 12)
+	// polynomial is known, so a single sample of each length is enough
+	doTest(CRC32ISCSI, ReverseOptions{Polynomial: 0x1EDC6F41}, 9, 17)
+}
+
+func TestReverseErrors(t *testing.T) {
+	samples := []Sample{{[]byte("123456789"), 0x4B37}, {[]byte("12345"), 0x3ff3}}
+	if _, err := Reverse(samples, ReverseOptions{Width: 16}); err != ErrNotEnoughSamples {
+		t.Errorf("Reverse searched for polynomial with samples of different lengths, got %v", err)
+	}
+	if _, err := Reverse(nil, ReverseOptions{Width: 16, Polynomial: 0x8005}); err != ErrNotEnoughSamples {
+		t.Errorf("Reverse accepted no samples, got %v", err)
+	}
+	if _, err := Reverse(samples, ReverseOptions{Width: 0}); err == nil {
+		t.Errorf("Reverse accepted zero width")
+	}
+
+	// x^16+1 divides x^(8*2)+1, so lengths differing by 2 bytes say nothing about Init
+	params := &Parameters{Width: 16, Polynomial: 0x0001, Init: 0x1234}
+	samples = []Sample{{[]byte("ab"), CalculateCRC(params, []byte("ab"))}, {[]byte("abcd"), CalculateCRC(params, []byte("abcd"))}}
+	if _, err := Reverse(samples, ReverseOptions{Width: 16, Polynomial: 0x0001}); err != ErrAmbiguousInit {
+		t.Errorf("Unexpected error %v when samples do not determine Init (should be ErrAmbiguousInit)", err)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import "fmt"
+
+// checkInput is the message Check values are calculated for
+var checkInput = []byte("123456789")
+
+// SelfTest verifies that both bit by bit (CalculateCRC) and table driven (NewTable) implementations
+// reproduce Check and Residue values specified in these Parameters. It is mostly useful to validate
+// custom parameter sets, e.g. loaded from configuration files, before they are put into use.
+// Parameters are validated first, see Validate.
+//
+// Residue is verified by feeding FinalXor (as it would be appended to a message) into a zeroed register,
+// which by linearity of CRC leaves the register in the same state as any error-free codeword does.
+func (crcParams *Parameters) SelfTest() error {
+	if err := crcParams.Validate(); err != nil {
+		return err
+	}
+	if calculated := CalculateCRC(crcParams, checkInput); calculated != crcParams.Check {
+		return fmt.Errorf("crc: check value 0x%X calculated bit by bit does not match expected 0x%X", calculated, crcParams.Check)
+	}
+	table := newOneOffTable(crcParams)
+	if calculated := table.CalculateCRC(checkInput); calculated != crcParams.Check {
+		return fmt.Errorf("crc: check value 0x%X calculated by table does not match expected 0x%X", calculated, crcParams.Check)
+	}
+
+	residueParams, residueInput := residueRun(crcParams)
+	if calculated := CalculateCRC(residueParams, residueInput); calculated != crcParams.Residue {
+		return fmt.Errorf("crc: residue 0x%X calculated bit by bit does not match expected 0x%X", calculated, crcParams.Residue)
+	}
+	if calculated := newOneOffTable(residueParams).CalculateCRC(residueInput); calculated != crcParams.Residue {
+		return fmt.Errorf("crc: residue 0x%X calculated by table does not match expected 0x%X", calculated, crcParams.Residue)
+	}
+	return nil
+}
+
+// residueRun returns parameters and input which produce residue of the CRC algorithm specified by crcParams.
+// Parameters are same as crcParams except for zero Init and FinalXor, while input holds FinalXor
+// bits in the order they are transmitted, padded with leading zero bits to a whole number of bytes.
+func residueRun(crcParams *Parameters) (*Parameters, []byte) {
+	residueParams := &Parameters{
+		Width:      crcParams.Width,
+		Polynomial: crcParams.Polynomial,
+		ReflectIn:  crcParams.ReflectIn,
+		ReflectOut: crcParams.ReflectOut,
+	}
+
+	value := crcParams.FinalXor
+	if crcParams.ReflectOut {
+		value = reflect(value, crcParams.Width)
+	}
+	size := (crcParams.Width + 7) / 8
+	if crcParams.ReflectIn {
+		// bytes are processed least significant bit first, so bit order has to be reversed as a whole
+		value = reflect(value, 8*size)
+	}
+
+	input := make([]byte, size)
+	for i := uint(0); i < size; i++ {
+		if crcParams.ReflectIn {
+			input[i] = byte(value >> (8 * i))
+		} else {
+			input[i] = byte(value >> (8 * (size - i - 1)))
+		}
+	}
+	return residueParams, input
+}
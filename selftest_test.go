@@ -0,0 +1,35 @@
+package crc
+
+import "testing"
+
+func TestSelfTest(t *testing.T) {
+	// XMODEM2 is left out as its polynomial is even, so it does not pass validation
+	for _, params := range []*Parameters{X25, CCITT, CRC16, XMODEM, CRC32, Castagnoli, Koopman, CRC64ISO, CRC64ECMA} {
+		if err := params.SelfTest(); err != nil {
+			t.Errorf("Self test failed for %+v: %v", *params, err)
+		}
+	}
+
+	// odd widths and mixed reflection with non-zero final xor
+	doTest := func(params *Parameters) {
+		if err := params.SelfTest(); err != nil {
+			t.Errorf("Self test failed for %+v: %v", *params, err)
+		}
+	}
+	doTest(&Parameters{Width: 5, Polynomial: 0x05, Init: 0x1F, ReflectIn: true, ReflectOut: true, FinalXor: 0x1F, Check: 0x19, Residue: 0x06})
+	doTest(&Parameters{Width: 12, Polynomial: 0xD31, Init: 0x000, ReflectIn: false, ReflectOut: false, FinalXor: 0xFFF, Check: 0xB34, Residue: 0x178})
+	doTest(&Parameters{Width: 12, Polynomial: 0x80F, Init: 0x000, ReflectIn: true, ReflectOut: false, FinalXor: 0xFFF, Check: 0x79C, Residue: 0x03A})
+	doTest(&Parameters{Width: 12, Polynomial: 0x80F, Init: 0x000, ReflectIn: false, ReflectOut: true, FinalXor: 0xFFF, Check: 0x250, Residue: 0x5C0})
+
+	// wrong check and residue values must be reported
+	wrongCheck := *CRC32
+	wrongCheck.Check ^= 1
+	if err := wrongCheck.SelfTest(); err == nil {
+		t.Errorf("Self test passed for wrong check value")
+	}
+	wrongResidue := *X25
+	wrongResidue.Residue ^= 0x8000
+	if err := wrongResidue.SelfTest(); err == nil {
+		t.Errorf("Self test passed for wrong residue value")
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import "encoding/binary"
+
+// slicingThreshold is the smallest number of bytes UpdateCrc processes with slicing-by-8 algorithm.
+// Shorter inputs are processed one byte at a time as slicing tables would hardly pay off.
+const slicingThreshold = 64
+
+// slicingTables returns tables for slicing-by-8 algorithm building them on first use.
+// Table k holds the register contribution of a byte followed by k zero bytes.
+//
+// For reflected algorithms tables hold register values as is. For non reflected ones values are
+// shifted to the top of uint64, so that the same code works for any width up to 64 bits.
+func (t *Table) slicingTables() *[8][256]uint64 {
+	t.slicingOnce.Do(func() {
+		tables := new([8][256]uint64)
+		if t.crcParams.ReflectIn {
+			copy(tables[0][:], t.crctable)
+			for k := 1; k < 8; k++ {
+				for i := 0; i < 256; i++ {
+					prev := tables[k-1][i]
+					tables[k][i] = tables[0][byte(prev)] ^ (prev >> 8)
+				}
+			}
+		} else {
+			shift := 64 - t.crcParams.Width
+			for i := 0; i < 256; i++ {
+				tables[0][i] = t.crctable[i] << shift
+			}
+			for k := 1; k < 8; k++ {
+				for i := 0; i < 256; i++ {
+					prev := tables[k-1][i]
+					tables[k][i] = tables[0][prev>>56] ^ (prev << 8)
+				}
+			}
+		}
+		t.slicing = tables
+	})
+	return t.slicing
+}
+
+// updateSlicing8 implements UpdateCrc processing 8 bytes at a time using slicing-by-8 algorithm.
+// Remaining tail (if any) is processed one byte at a time.
+func (t *Table) updateSlicing8(curValue uint64, p []byte) uint64 {
+	tables := t.slicingTables()
+	if t.crcParams.ReflectIn {
+		for ; len(p) >= 8; p = p[8:] {
+			curValue ^= binary.LittleEndian.Uint64(p)
+			curValue = tables[7][byte(curValue)] ^
+				tables[6][byte(curValue>>8)] ^
+				tables[5][byte(curValue>>16)] ^
+				tables[4][byte(curValue>>24)] ^
+				tables[3][byte(curValue>>32)] ^
+				tables[2][byte(curValue>>40)] ^
+				tables[1][byte(curValue>>48)] ^
+				tables[0][byte(curValue>>56)]
+		}
+	} else {
+		shift := 64 - t.crcParams.Width
+		curValue <<= shift
+		for ; len(p) >= 8; p = p[8:] {
+			curValue ^= binary.BigEndian.Uint64(p)
+			curValue = tables[7][byte(curValue>>56)] ^
+				tables[6][byte(curValue>>48)] ^
+				tables[5][byte(curValue>>40)] ^
+				tables[4][byte(curValue>>32)] ^
+				tables[3][byte(curValue>>24)] ^
+				tables[2][byte(curValue>>16)] ^
+				tables[1][byte(curValue>>8)] ^
+				tables[0][byte(curValue)]
+		}
+		curValue >>= shift
+	}
+	return t.updateBytewise(curValue, p)
+}
@@ -0,0 +1,58 @@
+package crc
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSlicing8(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 300)
+	rnd.Read(data)
+
+	doTest := func(params *Parameters) {
+		table := NewTable(params)
+		for _, length := range []int{0, 1, 7, 8, 9, 63, 64, 65, 128, 255, 300} {
+			expected := table.CRC(table.updateBytewise(table.InitCrc(), data[:length]))
+			calculated := table.CRC(table.updateSlicing8(table.InitCrc(), data[:length]))
+			if calculated != expected {
+				t.Errorf("Incorrect CRC 0x%04x calculated by slicing-by-8 for %s over %d bytes (should be 0x%04x)", calculated, params.Name, length, expected)
+			}
+		}
+		// slicing in the middle of a calculation
+		crc := table.updateBytewise(table.InitCrc(), data[:3])
+		crc = table.updateSlicing8(crc, data[3:200])
+		crc = table.updateBytewise(crc, data[200:])
+		if calculated := table.CRC(crc); calculated != CalculateCRC(params, data) {
+			t.Errorf("Incorrect CRC 0x%04x calculated by mixing slicing-by-8 and bytewise for %s", calculated, params.Name)
+		}
+	}
+
+	for _, entry := range catalogue {
+		doTest(entry.params)
+	}
+	doTest(XMODEM2)
+	doTest(Koopman)
+}
+
+func benchmarkUpdate(b *testing.B, params *Parameters, update func(t *Table, crc uint64, p []byte) uint64) {
+	table := NewTable(params)
+	data := make([]byte, 64*1024)
+	rand.New(rand.NewSource(1)).Read(data)
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		update(table, table.InitCrc(), data)
+	}
+}
+
+func BenchmarkUpdateCrc(b *testing.B) {
+	for _, params := range []*Parameters{CRC64ECMA182, CRC64XZ, CRC32MPEG2, CRC32, CCITT, CRC8SMBUS} {
+		b.Run(params.Name+"/bytewise", func(b *testing.B) {
+			benchmarkUpdate(b, params, (*Table).updateBytewise)
+		})
+		b.Run(params.Name+"/slicing8", func(b *testing.B) {
+			benchmarkUpdate(b, params, (*Table).updateSlicing8)
+		})
+	}
+}
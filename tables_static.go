@@ -0,0 +1,3024 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by go test -run TestStaticTables -update. DO NOT EDIT.
+
+package crc
+
+// staticTables holds precomputed tables for all built-in parameter sets, so that NewTable
+// does not need to calculate them.
+var staticTables = map[tableKey]*[256]uint64{
+	{16, 0x8408, true}: {
+		0x0000, 0x17CE, 0x0FDF, 0x1811, 0x1FBE, 0x0870, 0x1061, 0x07AF,
+		0x1F3F, 0x08F1, 0x10E0, 0x072E, 0x0081, 0x174F, 0x0F5E, 0x1890,
+		0x1E3D, 0x09F3, 0x11E2, 0x062C, 0x0183, 0x164D, 0x0E5C, 0x1992,
+		0x0102, 0x16CC, 0x0EDD, 0x1913, 0x1EBC, 0x0972, 0x1163, 0x06AD,
+		0x1C39, 0x0BF7, 0x13E6, 0x0428, 0x0387, 0x1449, 0x0C58, 0x1B96,
+		0x0306, 0x14C8, 0x0CD9, 0x1B17, 0x1CB8, 0x0B76, 0x1367, 0x04A9,
+		0x0204, 0x15CA, 0x0DDB, 0x1A15, 0x1DBA, 0x0A74, 0x1265, 0x05AB,
+		0x1D3B, 0x0AF5, 0x12E4, 0x052A, 0x0285, 0x154B, 0x0D5A, 0x1A94,
+		0x1831, 0x0FFF, 0x17EE, 0x0020, 0x078F, 0x1041, 0x0850, 0x1F9E,
+		0x070E, 0x10C0, 0x08D1, 0x1F1F, 0x18B0, 0x0F7E, 0x176F, 0x00A1,
+		0x060C, 0x11C2, 0x09D3, 0x1E1D, 0x19B2, 0x0E7C, 0x166D, 0x01A3,
+		0x1933, 0x0EFD, 0x16EC, 0x0122, 0x068D, 0x1143, 0x0952, 0x1E9C,
+		0x0408, 0x13C6, 0x0BD7, 0x1C19, 0x1BB6, 0x0C78, 0x1469, 0x03A7,
+		0x1B37, 0x0CF9, 0x14E8, 0x0326, 0x0489, 0x1347, 0x0B56, 0x1C98,
+		0x1A35, 0x0DFB, 0x15EA, 0x0224, 0x058B, 0x1245, 0x0A54, 0x1D9A,
+		0x050A, 0x12C4, 0x0AD5, 0x1D1B, 0x1AB4, 0x0D7A, 0x156B, 0x02A5,
+		0x1021, 0x07EF, 0x1FFE, 0x0830, 0x0F9F, 0x1851, 0x0040, 0x178E,
+		0x0F1E, 0x18D0, 0x00C1, 0x170F, 0x10A0, 0x076E, 0x1F7F, 0x08B1,
+		0x0E1C, 0x19D2, 0x01C3, 0x160D, 0x11A2, 0x066C, 0x1E7D, 0x09B3,
+		0x1123, 0x06ED, 0x1EFC, 0x0932, 0x0E9D, 0x1953, 0x0142, 0x168C,
+		0x0C18, 0x1BD6, 0x03C7, 0x1409, 0x13A6, 0x0468, 0x1C79, 0x0BB7,
+		0x1327, 0x04E9, 0x1CF8, 0x0B36, 0x0C99, 0x1B57, 0x0346, 0x1488,
+		0x1225, 0x05EB, 0x1DFA, 0x0A34, 0x0D9B, 0x1A55, 0x0244, 0x158A,
+		0x0D1A, 0x1AD4, 0x02C5, 0x150B, 0x12A4, 0x056A, 0x1D7B, 0x0AB5,
+		0x0810, 0x1FDE, 0x07CF, 0x1001, 0x17AE, 0x0060, 0x1871, 0x0FBF,
+		0x172F, 0x00E1, 0x18F0, 0x0F3E, 0x0891, 0x1F5F, 0x074E, 0x1080,
+		0x162D, 0x01E3, 0x19F2, 0x0E3C, 0x0993, 0x1E5D, 0x064C, 0x1182,
+		0x0912, 0x1EDC, 0x06CD, 0x1103, 0x16AC, 0x0162, 0x1973, 0x0EBD,
+		0x1429, 0x03E7, 0x1BF6, 0x0C38, 0x0B97, 0x1C59, 0x0448, 0x1386,
+		0x0B16, 0x1CD8, 0x04C9, 0x1307, 0x14A8, 0x0366, 0x1B77, 0x0CB9,
+		0x0A14, 0x1DDA, 0x05CB, 0x1205, 0x15AA, 0x0264, 0x1A75, 0x0DBB,
+		0x152B, 0x02E5, 0x1AF4, 0x0D3A, 0x0A95, 0x1D5B, 0x054A, 0x1284,
+	},
+	{32, 0x741B8CD7, true}: {
+		0x00000000, 0x9695C4CA, 0xFB4839C9, 0x6DDDFD03, 0x20F3C3CF, 0xB6660705, 0xDBBBFA06, 0x4D2E3ECC,
+		0x41E7879E, 0xD7724354, 0xBAAFBE57, 0x2C3A7A9D, 0x61144451, 0xF781809B, 0x9A5C7D98, 0x0CC9B952,
+		0x83CF0F3C, 0x155ACBF6, 0x788736F5, 0xEE12F23F, 0xA33CCCF3, 0x35A90839, 0x5874F53A, 0xCEE131F0,
+		0xC22888A2, 0x54BD4C68, 0x3960B16B, 0xAFF575A1, 0xE2DB4B6D, 0x744E8FA7, 0x199372A4, 0x8F06B66E,
+		0xD1FDAE25, 0x47686AEF, 0x2AB597EC, 0xBC205326, 0xF10E6DEA, 0x679BA920, 0x0A465423, 0x9CD390E9,
+		0x901A29BB, 0x068FED71, 0x6B521072, 0xFDC7D4B8, 0xB0E9EA74, 0x267C2EBE, 0x4BA1D3BD, 0xDD341777,
+		0x5232A119, 0xC4A765D3, 0xA97A98D0, 0x3FEF5C1A, 0x72C162D6, 0xE454A61C, 0x89895B1F, 0x1F1C9FD5,
+		0x13D52687, 0x8540E24D, 0xE89D1F4E, 0x7E08DB84, 0x3326E548, 0xA5B32182, 0xC86EDC81, 0x5EFB184B,
+		0x7598EC17, 0xE30D28DD, 0x8ED0D5DE, 0x18451114, 0x556B2FD8, 0xC3FEEB12, 0xAE231611, 0x38B6D2DB,
+		0x347F6B89, 0xA2EAAF43, 0xCF375240, 0x59A2968A, 0x148CA846, 0x82196C8C, 0xEFC4918F, 0x79515545,
+		0xF657E32B, 0x60C227E1, 0x0D1FDAE2, 0x9B8A1E28, 0xD6A420E4, 0x4031E42E, 0x2DEC192D, 0xBB79DDE7,
+		0xB7B064B5, 0x2125A07F, 0x4CF85D7C, 0xDA6D99B6, 0x9743A77A, 0x01D663B0, 0x6C0B9EB3, 0xFA9E5A79,
+		0xA4654232, 0x32F086F8, 0x5F2D7BFB, 0xC9B8BF31, 0x849681FD, 0x12034537, 0x7FDEB834, 0xE94B7CFE,
+		0xE582C5AC, 0x73170166, 0x1ECAFC65, 0x885F38AF, 0xC5710663, 0x53E4C2A9, 0x3E393FAA, 0xA8ACFB60,
+		0x27AA4D0E, 0xB13F89C4, 0xDCE274C7, 0x4A77B00D, 0x07598EC1, 0x91CC4A0B, 0xFC11B708, 0x6A8473C2,
+		0x664DCA90, 0xF0D80E5A, 0x9D05F359, 0x0B903793, 0x46BE095F, 0xD02BCD95, 0xBDF63096, 0x2B63F45C,
+		0xEB31D82E, 0x7DA41CE4, 0x1079E1E7, 0x86EC252D, 0xCBC21BE1, 0x5D57DF2B, 0x308A2228, 0xA61FE6E2,
+		0xAAD65FB0, 0x3C439B7A, 0x519E6679, 0xC70BA2B3, 0x8A259C7F, 0x1CB058B5, 0x716DA5B6, 0xE7F8617C,
+		0x68FED712, 0xFE6B13D8, 0x93B6EEDB, 0x05232A11, 0x480D14DD, 0xDE98D017, 0xB3452D14, 0x25D0E9DE,
+		0x2919508C, 0xBF8C9446, 0xD2516945, 0x44C4AD8F, 0x09EA9343, 0x9F7F5789, 0xF2A2AA8A, 0x64376E40,
+		0x3ACC760B, 0xAC59B2C1, 0xC1844FC2, 0x57118B08, 0x1A3FB5C4, 0x8CAA710E, 0xE1778C0D, 0x77E248C7,
+		0x7B2BF195, 0xEDBE355F, 0x8063C85C, 0x16F60C96, 0x5BD8325A, 0xCD4DF690, 0xA0900B93, 0x3605CF59,
+		0xB9037937, 0x2F96BDFD, 0x424B40FE, 0xD4DE8434, 0x99F0BAF8, 0x0F657E32, 0x62B88331, 0xF42D47FB,
+		0xF8E4FEA9, 0x6E713A63, 0x03ACC760, 0x953903AA, 0xD8173D66, 0x4E82F9AC, 0x235F04AF, 0xB5CAC065,
+		0x9EA93439, 0x083CF0F3, 0x65E10DF0, 0xF374C93A, 0xBE5AF7F6, 0x28CF333C, 0x4512CE3F, 0xD3870AF5,
+		0xDF4EB3A7, 0x49DB776D, 0x24068A6E, 0xB2934EA4, 0xFFBD7068, 0x6928B4A2, 0x04F549A1, 0x92608D6B,
+		0x1D663B05, 0x8BF3FFCF, 0xE62E02CC, 0x70BBC606, 0x3D95F8CA, 0xAB003C00, 0xC6DDC103, 0x504805C9,
+		0x5C81BC9B, 0xCA147851, 0xA7C98552, 0x315C4198, 0x7C727F54, 0xEAE7BB9E, 0x873A469D, 0x11AF8257,
+		0x4F549A1C, 0xD9C15ED6, 0xB41CA3D5, 0x2289671F, 0x6FA759D3, 0xF9329D19, 0x94EF601A, 0x027AA4D0,
+		0x0EB31D82, 0x9826D948, 0xF5FB244B, 0x636EE081, 0x2E40DE4D, 0xB8D51A87, 0xD508E784, 0x439D234E,
+		0xCC9B9520, 0x5A0E51EA, 0x37D3ACE9, 0xA1466823, 0xEC6856EF, 0x7AFD9225, 0x17206F26, 0x81B5ABEC,
+		0x8D7C12BE, 0x1BE9D674, 0x76342B77, 0xE0A1EFBD, 0xAD8FD171, 0x3B1A15BB, 0x56C7E8B8, 0xC0522C72,
+	},
+	{3, 0x3, false}: {
+		0x0, 0x3, 0x6, 0x5, 0x7, 0x4, 0x1, 0x2,
+		0x5, 0x6, 0x3, 0x0, 0x2, 0x1, 0x4, 0x7,
+		0x1, 0x2, 0x7, 0x4, 0x6, 0x5, 0x0, 0x3,
+		0x4, 0x7, 0x2, 0x1, 0x3, 0x0, 0x5, 0x6,
+		0x2, 0x1, 0x4, 0x7, 0x5, 0x6, 0x3, 0x0,
+		0x7, 0x4, 0x1, 0x2, 0x0, 0x3, 0x6, 0x5,
+		0x3, 0x0, 0x5, 0x6, 0x4, 0x7, 0x2, 0x1,
+		0x6, 0x5, 0x0, 0x3, 0x1, 0x2, 0x7, 0x4,
+		0x4, 0x7, 0x2, 0x1, 0x3, 0x0, 0x5, 0x6,
+		0x1, 0x2, 0x7, 0x4, 0x6, 0x5, 0x0, 0x3,
+		0x5, 0x6, 0x3, 0x0, 0x2, 0x1, 0x4, 0x7,
+		0x0, 0x3, 0x6, 0x5, 0x7, 0x4, 0x1, 0x2,
+		0x6, 0x5, 0x0, 0x3, 0x1, 0x2, 0x7, 0x4,
+		0x3, 0x0, 0x5, 0x6, 0x4, 0x7, 0x2, 0x1,
+		0x7, 0x4, 0x1, 0x2, 0x0, 0x3, 0x6, 0x5,
+		0x2, 0x1, 0x4, 0x7, 0x5, 0x6, 0x3, 0x0,
+		0x3, 0x0, 0x5, 0x6, 0x4, 0x7, 0x2, 0x1,
+		0x6, 0x5, 0x0, 0x3, 0x1, 0x2, 0x7, 0x4,
+		0x2, 0x1, 0x4, 0x7, 0x5, 0x6, 0x3, 0x0,
+		0x7, 0x4, 0x1, 0x2, 0x0, 0x3, 0x6, 0x5,
+		0x1, 0x2, 0x7, 0x4, 0x6, 0x5, 0x0, 0x3,
+		0x4, 0x7, 0x2, 0x1, 0x3, 0x0, 0x5, 0x6,
+		0x0, 0x3, 0x6, 0x5, 0x7, 0x4, 0x1, 0x2,
+		0x5, 0x6, 0x3, 0x0, 0x2, 0x1, 0x4, 0x7,
+		0x7, 0x4, 0x1, 0x2, 0x0, 0x3, 0x6, 0x5,
+		0x2, 0x1, 0x4, 0x7, 0x5, 0x6, 0x3, 0x0,
+		0x6, 0x5, 0x0, 0x3, 0x1, 0x2, 0x7, 0x4,
+		0x3, 0x0, 0x5, 0x6, 0x4, 0x7, 0x2, 0x1,
+		0x5, 0x6, 0x3, 0x0, 0x2, 0x1, 0x4, 0x7,
+		0x0, 0x3, 0x6, 0x5, 0x7, 0x4, 0x1, 0x2,
+		0x4, 0x7, 0x2, 0x1, 0x3, 0x0, 0x5, 0x6,
+		0x1, 0x2, 0x7, 0x4, 0x6, 0x5, 0x0, 0x3,
+	},
+	{3, 0x3, true}: {
+		0x0, 0x6, 0x1, 0x7, 0x2, 0x4, 0x3, 0x5,
+		0x4, 0x2, 0x5, 0x3, 0x6, 0x0, 0x7, 0x1,
+		0x5, 0x3, 0x4, 0x2, 0x7, 0x1, 0x6, 0x0,
+		0x1, 0x7, 0x0, 0x6, 0x3, 0x5, 0x2, 0x4,
+		0x7, 0x1, 0x6, 0x0, 0x5, 0x3, 0x4, 0x2,
+		0x3, 0x5, 0x2, 0x4, 0x1, 0x7, 0x0, 0x6,
+		0x2, 0x4, 0x3, 0x5, 0x0, 0x6, 0x1, 0x7,
+		0x6, 0x0, 0x7, 0x1, 0x4, 0x2, 0x5, 0x3,
+		0x3, 0x5, 0x2, 0x4, 0x1, 0x7, 0x0, 0x6,
+		0x7, 0x1, 0x6, 0x0, 0x5, 0x3, 0x4, 0x2,
+		0x6, 0x0, 0x7, 0x1, 0x4, 0x2, 0x5, 0x3,
+		0x2, 0x4, 0x3, 0x5, 0x0, 0x6, 0x1, 0x7,
+		0x4, 0x2, 0x5, 0x3, 0x6, 0x0, 0x7, 0x1,
+		0x0, 0x6, 0x1, 0x7, 0x2, 0x4, 0x3, 0x5,
+		0x1, 0x7, 0x0, 0x6, 0x3, 0x5, 0x2, 0x4,
+		0x5, 0x3, 0x4, 0x2, 0x7, 0x1, 0x6, 0x0,
+		0x6, 0x0, 0x7, 0x1, 0x4, 0x2, 0x5, 0x3,
+		0x2, 0x4, 0x3, 0x5, 0x0, 0x6, 0x1, 0x7,
+		0x3, 0x5, 0x2, 0x4, 0x1, 0x7, 0x0, 0x6,
+		0x7, 0x1, 0x6, 0x0, 0x5, 0x3, 0x4, 0x2,
+		0x1, 0x7, 0x0, 0x6, 0x3, 0x5, 0x2, 0x4,
+		0x5, 0x3, 0x4, 0x2, 0x7, 0x1, 0x6, 0x0,
+		0x4, 0x2, 0x5, 0x3, 0x6, 0x0, 0x7, 0x1,
+		0x0, 0x6, 0x1, 0x7, 0x2, 0x4, 0x3, 0x5,
+		0x5, 0x3, 0x4, 0x2, 0x7, 0x1, 0x6, 0x0,
+		0x1, 0x7, 0x0, 0x6, 0x3, 0x5, 0x2, 0x4,
+		0x0, 0x6, 0x1, 0x7, 0x2, 0x4, 0x3, 0x5,
+		0x4, 0x2, 0x5, 0x3, 0x6, 0x0, 0x7, 0x1,
+		0x2, 0x4, 0x3, 0x5, 0x0, 0x6, 0x1, 0x7,
+		0x6, 0x0, 0x7, 0x1, 0x4, 0x2, 0x5, 0x3,
+		0x7, 0x1, 0x6, 0x0, 0x5, 0x3, 0x4, 0x2,
+		0x3, 0x5, 0x2, 0x4, 0x1, 0x7, 0x0, 0x6,
+	},
+	{4, 0x3, true}: {
+		0x0, 0x7, 0xE, 0x9, 0x5, 0x2, 0xB, 0xC,
+		0xA, 0xD, 0x4, 0x3, 0xF, 0x8, 0x1, 0x6,
+		0xD, 0xA, 0x3, 0x4, 0x8, 0xF, 0x6, 0x1,
+		0x7, 0x0, 0x9, 0xE, 0x2, 0x5, 0xC, 0xB,
+		0x3, 0x4, 0xD, 0xA, 0x6, 0x1, 0x8, 0xF,
+		0x9, 0xE, 0x7, 0x0, 0xC, 0xB, 0x2, 0x5,
+		0xE, 0x9, 0x0, 0x7, 0xB, 0xC, 0x5, 0x2,
+		0x4, 0x3, 0xA, 0xD, 0x1, 0x6, 0xF, 0x8,
+		0x6, 0x1, 0x8, 0xF, 0x3, 0x4, 0xD, 0xA,
+		0xC, 0xB, 0x2, 0x5, 0x9, 0xE, 0x7, 0x0,
+		0xB, 0xC, 0x5, 0x2, 0xE, 0x9, 0x0, 0x7,
+		0x1, 0x6, 0xF, 0x8, 0x4, 0x3, 0xA, 0xD,
+		0x5, 0x2, 0xB, 0xC, 0x0, 0x7, 0xE, 0x9,
+		0xF, 0x8, 0x1, 0x6, 0xA, 0xD, 0x4, 0x3,
+		0x8, 0xF, 0x6, 0x1, 0xD, 0xA, 0x3, 0x4,
+		0x2, 0x5, 0xC, 0xB, 0x7, 0x0, 0x9, 0xE,
+		0xC, 0xB, 0x2, 0x5, 0x9, 0xE, 0x7, 0x0,
+		0x6, 0x1, 0x8, 0xF, 0x3, 0x4, 0xD, 0xA,
+		0x1, 0x6, 0xF, 0x8, 0x4, 0x3, 0xA, 0xD,
+		0xB, 0xC, 0x5, 0x2, 0xE, 0x9, 0x0, 0x7,
+		0xF, 0x8, 0x1, 0x6, 0xA, 0xD, 0x4, 0x3,
+		0x5, 0x2, 0xB, 0xC, 0x0, 0x7, 0xE, 0x9,
+		0x2, 0x5, 0xC, 0xB, 0x7, 0x0, 0x9, 0xE,
+		0x8, 0xF, 0x6, 0x1, 0xD, 0xA, 0x3, 0x4,
+		0xA, 0xD, 0x4, 0x3, 0xF, 0x8, 0x1, 0x6,
+		0x0, 0x7, 0xE, 0x9, 0x5, 0x2, 0xB, 0xC,
+		0x7, 0x0, 0x9, 0xE, 0x2, 0x5, 0xC, 0xB,
+		0xD, 0xA, 0x3, 0x4, 0x8, 0xF, 0x6, 0x1,
+		0x9, 0xE, 0x7, 0x0, 0xC, 0xB, 0x2, 0x5,
+		0x3, 0x4, 0xD, 0xA, 0x6, 0x1, 0x8, 0xF,
+		0x4, 0x3, 0xA, 0xD, 0x1, 0x6, 0xF, 0x8,
+		0xE, 0x9, 0x0, 0x7, 0xB, 0xC, 0x5, 0x2,
+	},
+	{4, 0x3, false}: {
+		0x0, 0x3, 0x6, 0x5, 0xC, 0xF, 0xA, 0x9,
+		0xB, 0x8, 0xD, 0xE, 0x7, 0x4, 0x1, 0x2,
+		0x5, 0x6, 0x3, 0x0, 0x9, 0xA, 0xF, 0xC,
+		0xE, 0xD, 0x8, 0xB, 0x2, 0x1, 0x4, 0x7,
+		0xA, 0x9, 0xC, 0xF, 0x6, 0x5, 0x0, 0x3,
+		0x1, 0x2, 0x7, 0x4, 0xD, 0xE, 0xB, 0x8,
+		0xF, 0xC, 0x9, 0xA, 0x3, 0x0, 0x5, 0x6,
+		0x4, 0x7, 0x2, 0x1, 0x8, 0xB, 0xE, 0xD,
+		0x7, 0x4, 0x1, 0x2, 0xB, 0x8, 0xD, 0xE,
+		0xC, 0xF, 0xA, 0x9, 0x0, 0x3, 0x6, 0x5,
+		0x2, 0x1, 0x4, 0x7, 0xE, 0xD, 0x8, 0xB,
+		0x9, 0xA, 0xF, 0xC, 0x5, 0x6, 0x3, 0x0,
+		0xD, 0xE, 0xB, 0x8, 0x1, 0x2, 0x7, 0x4,
+		0x6, 0x5, 0x0, 0x3, 0xA, 0x9, 0xC, 0xF,
+		0x8, 0xB, 0xE, 0xD, 0x4, 0x7, 0x2, 0x1,
+		0x3, 0x0, 0x5, 0x6, 0xF, 0xC, 0x9, 0xA,
+		0xE, 0xD, 0x8, 0xB, 0x2, 0x1, 0x4, 0x7,
+		0x5, 0x6, 0x3, 0x0, 0x9, 0xA, 0xF, 0xC,
+		0xB, 0x8, 0xD, 0xE, 0x7, 0x4, 0x1, 0x2,
+		0x0, 0x3, 0x6, 0x5, 0xC, 0xF, 0xA, 0x9,
+		0x4, 0x7, 0x2, 0x1, 0x8, 0xB, 0xE, 0xD,
+		0xF, 0xC, 0x9, 0xA, 0x3, 0x0, 0x5, 0x6,
+		0x1, 0x2, 0x7, 0x4, 0xD, 0xE, 0xB, 0x8,
+		0xA, 0x9, 0xC, 0xF, 0x6, 0x5, 0x0, 0x3,
+		0x9, 0xA, 0xF, 0xC, 0x5, 0x6, 0x3, 0x0,
+		0x2, 0x1, 0x4, 0x7, 0xE, 0xD, 0x8, 0xB,
+		0xC, 0xF, 0xA, 0x9, 0x0, 0x3, 0x6, 0x5,
+		0x7, 0x4, 0x1, 0x2, 0xB, 0x8, 0xD, 0xE,
+		0x3, 0x0, 0x5, 0x6, 0xF, 0xC, 0x9, 0xA,
+		0x8, 0xB, 0xE, 0xD, 0x4, 0x7, 0x2, 0x1,
+		0x6, 0x5, 0x0, 0x3, 0xA, 0x9, 0xC, 0xF,
+		0xD, 0xE, 0xB, 0x8, 0x1, 0x2, 0x7, 0x4,
+	},
+	{5, 0x9, false}: {
+		0x00, 0x09, 0x12, 0x1B, 0x0D, 0x04, 0x1F, 0x16,
+		0x1A, 0x13, 0x08, 0x01, 0x17, 0x1E, 0x05, 0x0C,
+		0x1D, 0x14, 0x0F, 0x06, 0x10, 0x19, 0x02, 0x0B,
+		0x07, 0x0E, 0x15, 0x1C, 0x0A, 0x03, 0x18, 0x11,
+		0x13, 0x1A, 0x01, 0x08, 0x1E, 0x17, 0x0C, 0x05,
+		0x09, 0x00, 0x1B, 0x12, 0x04, 0x0D, 0x16, 0x1F,
+		0x0E, 0x07, 0x1C, 0x15, 0x03, 0x0A, 0x11, 0x18,
+		0x14, 0x1D, 0x06, 0x0F, 0x19, 0x10, 0x0B, 0x02,
+		0x0F, 0x06, 0x1D, 0x14, 0x02, 0x0B, 0x10, 0x19,
+		0x15, 0x1C, 0x07, 0x0E, 0x18, 0x11, 0x0A, 0x03,
+		0x12, 0x1B, 0x00, 0x09, 0x1F, 0x16, 0x0D, 0x04,
+		0x08, 0x01, 0x1A, 0x13, 0x05, 0x0C, 0x17, 0x1E,
+		0x1C, 0x15, 0x0E, 0x07, 0x11, 0x18, 0x03, 0x0A,
+		0x06, 0x0F, 0x14, 0x1D, 0x0B, 0x02, 0x19, 0x10,
+		0x01, 0x08, 0x13, 0x1A, 0x0C, 0x05, 0x1E, 0x17,
+		0x1B, 0x12, 0x09, 0x00, 0x16, 0x1F, 0x04, 0x0D,
+		0x1E, 0x17, 0x0C, 0x05, 0x13, 0x1A, 0x01, 0x08,
+		0x04, 0x0D, 0x16, 0x1F, 0x09, 0x00, 0x1B, 0x12,
+		0x03, 0x0A, 0x11, 0x18, 0x0E, 0x07, 0x1C, 0x15,
+		0x19, 0x10, 0x0B, 0x02, 0x14, 0x1D, 0x06, 0x0F,
+		0x0D, 0x04, 0x1F, 0x16, 0x00, 0x09, 0x12, 0x1B,
+		0x17, 0x1E, 0x05, 0x0C, 0x1A, 0x13, 0x08, 0x01,
+		0x10, 0x19, 0x02, 0x0B, 0x1D, 0x14, 0x0F, 0x06,
+		0x0A, 0x03, 0x18, 0x11, 0x07, 0x0E, 0x15, 0x1C,
+		0x11, 0x18, 0x03, 0x0A, 0x1C, 0x15, 0x0E, 0x07,
+		0x0B, 0x02, 0x19, 0x10, 0x06, 0x0F, 0x14, 0x1D,
+		0x0C, 0x05, 0x1E, 0x17, 0x01, 0x08, 0x13, 0x1A,
+		0x16, 0x1F, 0x04, 0x0D, 0x1B, 0x12, 0x09, 0x00,
+		0x02, 0x0B, 0x10, 0x19, 0x0F, 0x06, 0x1D, 0x14,
+		0x18, 0x11, 0x0A, 0x03, 0x15, 0x1C, 0x07, 0x0E,
+		0x1F, 0x16, 0x0D, 0x04, 0x12, 0x1B, 0x00, 0x09,
+		0x05, 0x0C, 0x17, 0x1E, 0x08, 0x01, 0x1A, 0x13,
+	},
+	{5, 0x15, true}: {
+		0x00, 0x07, 0x0E, 0x09, 0x1C, 0x1B, 0x12, 0x15,
+		0x13, 0x14, 0x1D, 0x1A, 0x0F, 0x08, 0x01, 0x06,
+		0x0D, 0x0A, 0x03, 0x04, 0x11, 0x16, 0x1F, 0x18,
+		0x1E, 0x19, 0x10, 0x17, 0x02, 0x05, 0x0C, 0x0B,
+		0x1A, 0x1D, 0x14, 0x13, 0x06, 0x01, 0x08, 0x0F,
+		0x09, 0x0E, 0x07, 0x00, 0x15, 0x12, 0x1B, 0x1C,
+		0x17, 0x10, 0x19, 0x1E, 0x0B, 0x0C, 0x05, 0x02,
+		0x04, 0x03, 0x0A, 0x0D, 0x18, 0x1F, 0x16, 0x11,
+		0x1F, 0x18, 0x11, 0x16, 0x03, 0x04, 0x0D, 0x0A,
+		0x0C, 0x0B, 0x02, 0x05, 0x10, 0x17, 0x1E, 0x19,
+		0x12, 0x15, 0x1C, 0x1B, 0x0E, 0x09, 0x00, 0x07,
+		0x01, 0x06, 0x0F, 0x08, 0x1D, 0x1A, 0x13, 0x14,
+		0x05, 0x02, 0x0B, 0x0C, 0x19, 0x1E, 0x17, 0x10,
+		0x16, 0x11, 0x18, 0x1F, 0x0A, 0x0D, 0x04, 0x03,
+		0x08, 0x0F, 0x06, 0x01, 0x14, 0x13, 0x1A, 0x1D,
+		0x1B, 0x1C, 0x15, 0x12, 0x07, 0x00, 0x09, 0x0E,
+		0x15, 0x12, 0x1B, 0x1C, 0x09, 0x0E, 0x07, 0x00,
+		0x06, 0x01, 0x08, 0x0F, 0x1A, 0x1D, 0x14, 0x13,
+		0x18, 0x1F, 0x16, 0x11, 0x04, 0x03, 0x0A, 0x0D,
+		0x0B, 0x0C, 0x05, 0x02, 0x17, 0x10, 0x19, 0x1E,
+		0x0F, 0x08, 0x01, 0x06, 0x13, 0x14, 0x1D, 0x1A,
+		0x1C, 0x1B, 0x12, 0x15, 0x00, 0x07, 0x0E, 0x09,
+		0x02, 0x05, 0x0C, 0x0B, 0x1E, 0x19, 0x10, 0x17,
+		0x11, 0x16, 0x1F, 0x18, 0x0D, 0x0A, 0x03, 0x04,
+		0x0A, 0x0D, 0x04, 0x03, 0x16, 0x11, 0x18, 0x1F,
+		0x19, 0x1E, 0x17, 0x10, 0x05, 0x02, 0x0B, 0x0C,
+		0x07, 0x00, 0x09, 0x0E, 0x1B, 0x1C, 0x15, 0x12,
+		0x14, 0x13, 0x1A, 0x1D, 0x08, 0x0F, 0x06, 0x01,
+		0x10, 0x17, 0x1E, 0x19, 0x0C, 0x0B, 0x02, 0x05,
+		0x03, 0x04, 0x0D, 0x0A, 0x1F, 0x18, 0x11, 0x16,
+		0x1D, 0x1A, 0x13, 0x14, 0x01, 0x06, 0x0F, 0x08,
+		0x0E, 0x09, 0x00, 0x07, 0x12, 0x15, 0x1C, 0x1B,
+	},
+	{5, 0x5, true}: {
+		0x00, 0x0E, 0x1C, 0x12, 0x11, 0x1F, 0x0D, 0x03,
+		0x0B, 0x05, 0x17, 0x19, 0x1A, 0x14, 0x06, 0x08,
+		0x16, 0x18, 0x0A, 0x04, 0x07, 0x09, 0x1B, 0x15,
+		0x1D, 0x13, 0x01, 0x0F, 0x0C, 0x02, 0x10, 0x1E,
+		0x05, 0x0B, 0x19, 0x17, 0x14, 0x1A, 0x08, 0x06,
+		0x0E, 0x00, 0x12, 0x1C, 0x1F, 0x11, 0x03, 0x0D,
+		0x13, 0x1D, 0x0F, 0x01, 0x02, 0x0C, 0x1E, 0x10,
+		0x18, 0x16, 0x04, 0x0A, 0x09, 0x07, 0x15, 0x1B,
+		0x0A, 0x04, 0x16, 0x18, 0x1B, 0x15, 0x07, 0x09,
+		0x01, 0x0F, 0x1D, 0x13, 0x10, 0x1E, 0x0C, 0x02,
+		0x1C, 0x12, 0x00, 0x0E, 0x0D, 0x03, 0x11, 0x1F,
+		0x17, 0x19, 0x0B, 0x05, 0x06, 0x08, 0x1A, 0x14,
+		0x0F, 0x01, 0x13, 0x1D, 0x1E, 0x10, 0x02, 0x0C,
+		0x04, 0x0A, 0x18, 0x16, 0x15, 0x1B, 0x09, 0x07,
+		0x19, 0x17, 0x05, 0x0B, 0x08, 0x06, 0x14, 0x1A,
+		0x12, 0x1C, 0x0E, 0x00, 0x03, 0x0D, 0x1F, 0x11,
+		0x14, 0x1A, 0x08, 0x06, 0x05, 0x0B, 0x19, 0x17,
+		0x1F, 0x11, 0x03, 0x0D, 0x0E, 0x00, 0x12, 0x1C,
+		0x02, 0x0C, 0x1E, 0x10, 0x13, 0x1D, 0x0F, 0x01,
+		0x09, 0x07, 0x15, 0x1B, 0x18, 0x16, 0x04, 0x0A,
+		0x11, 0x1F, 0x0D, 0x03, 0x00, 0x0E, 0x1C, 0x12,
+		0x1A, 0x14, 0x06, 0x08, 0x0B, 0x05, 0x17, 0x19,
+		0x07, 0x09, 0x1B, 0x15, 0x16, 0x18, 0x0A, 0x04,
+		0x0C, 0x02, 0x10, 0x1E, 0x1D, 0x13, 0x01, 0x0F,
+		0x1E, 0x10, 0x02, 0x0C, 0x0F, 0x01, 0x13, 0x1D,
+		0x15, 0x1B, 0x09, 0x07, 0x04, 0x0A, 0x18, 0x16,
+		0x08, 0x06, 0x14, 0x1A, 0x19, 0x17, 0x05, 0x0B,
+		0x03, 0x0D, 0x1F, 0x11, 0x12, 0x1C, 0x0E, 0x00,
+		0x1B, 0x15, 0x07, 0x09, 0x0A, 0x04, 0x16, 0x18,
+		0x10, 0x1E, 0x0C, 0x02, 0x01, 0x0F, 0x1D, 0x13,
+		0x0D, 0x03, 0x11, 0x1F, 0x1C, 0x12, 0x00, 0x0E,
+		0x06, 0x08, 0x1A, 0x14, 0x17, 0x19, 0x0B, 0x05,
+	},
+	{6, 0x27, false}: {
+		0x00, 0x27, 0x29, 0x0E, 0x35, 0x12, 0x1C, 0x3B,
+		0x0D, 0x2A, 0x24, 0x03, 0x38, 0x1F, 0x11, 0x36,
+		0x1A, 0x3D, 0x33, 0x14, 0x2F, 0x08, 0x06, 0x21,
+		0x17, 0x30, 0x3E, 0x19, 0x22, 0x05, 0x0B, 0x2C,
+		0x34, 0x13, 0x1D, 0x3A, 0x01, 0x26, 0x28, 0x0F,
+		0x39, 0x1E, 0x10, 0x37, 0x0C, 0x2B, 0x25, 0x02,
+		0x2E, 0x09, 0x07, 0x20, 0x1B, 0x3C, 0x32, 0x15,
+		0x23, 0x04, 0x0A, 0x2D, 0x16, 0x31, 0x3F, 0x18,
+		0x0F, 0x28, 0x26, 0x01, 0x3A, 0x1D, 0x13, 0x34,
+		0x02, 0x25, 0x2B, 0x0C, 0x37, 0x10, 0x1E, 0x39,
+		0x15, 0x32, 0x3C, 0x1B, 0x20, 0x07, 0x09, 0x2E,
+		0x18, 0x3F, 0x31, 0x16, 0x2D, 0x0A, 0x04, 0x23,
+		0x3B, 0x1C, 0x12, 0x35, 0x0E, 0x29, 0x27, 0x00,
+		0x36, 0x11, 0x1F, 0x38, 0x03, 0x24, 0x2A, 0x0D,
+		0x21, 0x06, 0x08, 0x2F, 0x14, 0x33, 0x3D, 0x1A,
+		0x2C, 0x0B, 0x05, 0x22, 0x19, 0x3E, 0x30, 0x17,
+		0x1E, 0x39, 0x37, 0x10, 0x2B, 0x0C, 0x02, 0x25,
+		0x13, 0x34, 0x3A, 0x1D, 0x26, 0x01, 0x0F, 0x28,
+		0x04, 0x23, 0x2D, 0x0A, 0x31, 0x16, 0x18, 0x3F,
+		0x09, 0x2E, 0x20, 0x07, 0x3C, 0x1B, 0x15, 0x32,
+		0x2A, 0x0D, 0x03, 0x24, 0x1F, 0x38, 0x36, 0x11,
+		0x27, 0x00, 0x0E, 0x29, 0x12, 0x35, 0x3B, 0x1C,
+		0x30, 0x17, 0x19, 0x3E, 0x05, 0x22, 0x2C, 0x0B,
+		0x3D, 0x1A, 0x14, 0x33, 0x08, 0x2F, 0x21, 0x06,
+		0x11, 0x36, 0x38, 0x1F, 0x24, 0x03, 0x0D, 0x2A,
+		0x1C, 0x3B, 0x35, 0x12, 0x29, 0x0E, 0x00, 0x27,
+		0x0B, 0x2C, 0x22, 0x05, 0x3E, 0x19, 0x17, 0x30,
+		0x06, 0x21, 0x2F, 0x08, 0x33, 0x14, 0x1A, 0x3D,
+		0x25, 0x02, 0x0C, 0x2B, 0x10, 0x37, 0x39, 0x1E,
+		0x28, 0x0F, 0x01, 0x26, 0x1D, 0x3A, 0x34, 0x13,
+		0x3F, 0x18, 0x16, 0x31, 0x0A, 0x2D, 0x23, 0x04,
+		0x32, 0x15, 0x1B, 0x3C, 0x07, 0x20, 0x2E, 0x09,
+	},
+	{6, 0x7, false}: {
+		0x00, 0x07, 0x0E, 0x09, 0x1C, 0x1B, 0x12, 0x15,
+		0x38, 0x3F, 0x36, 0x31, 0x24, 0x23, 0x2A, 0x2D,
+		0x37, 0x30, 0x39, 0x3E, 0x2B, 0x2C, 0x25, 0x22,
+		0x0F, 0x08, 0x01, 0x06, 0x13, 0x14, 0x1D, 0x1A,
+		0x29, 0x2E, 0x27, 0x20, 0x35, 0x32, 0x3B, 0x3C,
+		0x11, 0x16, 0x1F, 0x18, 0x0D, 0x0A, 0x03, 0x04,
+		0x1E, 0x19, 0x10, 0x17, 0x02, 0x05, 0x0C, 0x0B,
+		0x26, 0x21, 0x28, 0x2F, 0x3A, 0x3D, 0x34, 0x33,
+		0x15, 0x12, 0x1B, 0x1C, 0x09, 0x0E, 0x07, 0x00,
+		0x2D, 0x2A, 0x23, 0x24, 0x31, 0x36, 0x3F, 0x38,
+		0x22, 0x25, 0x2C, 0x2B, 0x3E, 0x39, 0x30, 0x37,
+		0x1A, 0x1D, 0x14, 0x13, 0x06, 0x01, 0x08, 0x0F,
+		0x3C, 0x3B, 0x32, 0x35, 0x20, 0x27, 0x2E, 0x29,
+		0x04, 0x03, 0x0A, 0x0D, 0x18, 0x1F, 0x16, 0x11,
+		0x0B, 0x0C, 0x05, 0x02, 0x17, 0x10, 0x19, 0x1E,
+		0x33, 0x34, 0x3D, 0x3A, 0x2F, 0x28, 0x21, 0x26,
+		0x2A, 0x2D, 0x24, 0x23, 0x36, 0x31, 0x38, 0x3F,
+		0x12, 0x15, 0x1C, 0x1B, 0x0E, 0x09, 0x00, 0x07,
+		0x1D, 0x1A, 0x13, 0x14, 0x01, 0x06, 0x0F, 0x08,
+		0x25, 0x22, 0x2B, 0x2C, 0x39, 0x3E, 0x37, 0x30,
+		0x03, 0x04, 0x0D, 0x0A, 0x1F, 0x18, 0x11, 0x16,
+		0x3B, 0x3C, 0x35, 0x32, 0x27, 0x20, 0x29, 0x2E,
+		0x34, 0x33, 0x3A, 0x3D, 0x28, 0x2F, 0x26, 0x21,
+		0x0C, 0x0B, 0x02, 0x05, 0x10, 0x17, 0x1E, 0x19,
+		0x3F, 0x38, 0x31, 0x36, 0x23, 0x24, 0x2D, 0x2A,
+		0x07, 0x00, 0x09, 0x0E, 0x1B, 0x1C, 0x15, 0x12,
+		0x08, 0x0F, 0x06, 0x01, 0x14, 0x13, 0x1A, 0x1D,
+		0x30, 0x37, 0x3E, 0x39, 0x2C, 0x2B, 0x22, 0x25,
+		0x16, 0x11, 0x18, 0x1F, 0x0A, 0x0D, 0x04, 0x03,
+		0x2E, 0x29, 0x20, 0x27, 0x32, 0x35, 0x3C, 0x3B,
+		0x21, 0x26, 0x2F, 0x28, 0x3D, 0x3A, 0x33, 0x34,
+		0x19, 0x1E, 0x17, 0x10, 0x05, 0x02, 0x0B, 0x0C,
+	},
+	{6, 0x19, true}: {
+		0x00, 0x32, 0x29, 0x1B, 0x1F, 0x2D, 0x36, 0x04,
+		0x3E, 0x0C, 0x17, 0x25, 0x21, 0x13, 0x08, 0x3A,
+		0x31, 0x03, 0x18, 0x2A, 0x2E, 0x1C, 0x07, 0x35,
+		0x0F, 0x3D, 0x26, 0x14, 0x10, 0x22, 0x39, 0x0B,
+		0x2F, 0x1D, 0x06, 0x34, 0x30, 0x02, 0x19, 0x2B,
+		0x11, 0x23, 0x38, 0x0A, 0x0E, 0x3C, 0x27, 0x15,
+		0x1E, 0x2C, 0x37, 0x05, 0x01, 0x33, 0x28, 0x1A,
+		0x20, 0x12, 0x09, 0x3B, 0x3F, 0x0D, 0x16, 0x24,
+		0x13, 0x21, 0x3A, 0x08, 0x0C, 0x3E, 0x25, 0x17,
+		0x2D, 0x1F, 0x04, 0x36, 0x32, 0x00, 0x1B, 0x29,
+		0x22, 0x10, 0x0B, 0x39, 0x3D, 0x0F, 0x14, 0x26,
+		0x1C, 0x2E, 0x35, 0x07, 0x03, 0x31, 0x2A, 0x18,
+		0x3C, 0x0E, 0x15, 0x27, 0x23, 0x11, 0x0A, 0x38,
+		0x02, 0x30, 0x2B, 0x19, 0x1D, 0x2F, 0x34, 0x06,
+		0x0D, 0x3F, 0x24, 0x16, 0x12, 0x20, 0x3B, 0x09,
+		0x33, 0x01, 0x1A, 0x28, 0x2C, 0x1E, 0x05, 0x37,
+		0x26, 0x14, 0x0F, 0x3D, 0x39, 0x0B, 0x10, 0x22,
+		0x18, 0x2A, 0x31, 0x03, 0x07, 0x35, 0x2E, 0x1C,
+		0x17, 0x25, 0x3E, 0x0C, 0x08, 0x3A, 0x21, 0x13,
+		0x29, 0x1B, 0x00, 0x32, 0x36, 0x04, 0x1F, 0x2D,
+		0x09, 0x3B, 0x20, 0x12, 0x16, 0x24, 0x3F, 0x0D,
+		0x37, 0x05, 0x1E, 0x2C, 0x28, 0x1A, 0x01, 0x33,
+		0x38, 0x0A, 0x11, 0x23, 0x27, 0x15, 0x0E, 0x3C,
+		0x06, 0x34, 0x2F, 0x1D, 0x19, 0x2B, 0x30, 0x02,
+		0x35, 0x07, 0x1C, 0x2E, 0x2A, 0x18, 0x03, 0x31,
+		0x0B, 0x39, 0x22, 0x10, 0x14, 0x26, 0x3D, 0x0F,
+		0x04, 0x36, 0x2D, 0x1F, 0x1B, 0x29, 0x32, 0x00,
+		0x3A, 0x08, 0x13, 0x21, 0x25, 0x17, 0x0C, 0x3E,
+		0x1A, 0x28, 0x33, 0x01, 0x05, 0x37, 0x2C, 0x1E,
+		0x24, 0x16, 0x0D, 0x3F, 0x3B, 0x09, 0x12, 0x20,
+		0x2B, 0x19, 0x02, 0x30, 0x34, 0x06, 0x1D, 0x2F,
+		0x15, 0x27, 0x3C, 0x0E, 0x0A, 0x38, 0x23, 0x11,
+	},
+	{6, 0x3, true}: {
+		0x00, 0x14, 0x28, 0x3C, 0x31, 0x25, 0x19, 0x0D,
+		0x03, 0x17, 0x2B, 0x3F, 0x32, 0x26, 0x1A, 0x0E,
+		0x06, 0x12, 0x2E, 0x3A, 0x37, 0x23, 0x1F, 0x0B,
+		0x05, 0x11, 0x2D, 0x39, 0x34, 0x20, 0x1C, 0x08,
+		0x0C, 0x18, 0x24, 0x30, 0x3D, 0x29, 0x15, 0x01,
+		0x0F, 0x1B, 0x27, 0x33, 0x3E, 0x2A, 0x16, 0x02,
+		0x0A, 0x1E, 0x22, 0x36, 0x3B, 0x2F, 0x13, 0x07,
+		0x09, 0x1D, 0x21, 0x35, 0x38, 0x2C, 0x10, 0x04,
+		0x18, 0x0C, 0x30, 0x24, 0x29, 0x3D, 0x01, 0x15,
+		0x1B, 0x0F, 0x33, 0x27, 0x2A, 0x3E, 0x02, 0x16,
+		0x1E, 0x0A, 0x36, 0x22, 0x2F, 0x3B, 0x07, 0x13,
+		0x1D, 0x09, 0x35, 0x21, 0x2C, 0x38, 0x04, 0x10,
+		0x14, 0x00, 0x3C, 0x28, 0x25, 0x31, 0x0D, 0x19,
+		0x17, 0x03, 0x3F, 0x2B, 0x26, 0x32, 0x0E, 0x1A,
+		0x12, 0x06, 0x3A, 0x2E, 0x23, 0x37, 0x0B, 0x1F,
+		0x11, 0x05, 0x39, 0x2D, 0x20, 0x34, 0x08, 0x1C,
+		0x30, 0x24, 0x18, 0x0C, 0x01, 0x15, 0x29, 0x3D,
+		0x33, 0x27, 0x1B, 0x0F, 0x02, 0x16, 0x2A, 0x3E,
+		0x36, 0x22, 0x1E, 0x0A, 0x07, 0x13, 0x2F, 0x3B,
+		0x35, 0x21, 0x1D, 0x09, 0x04, 0x10, 0x2C, 0x38,
+		0x3C, 0x28, 0x14, 0x00, 0x0D, 0x19, 0x25, 0x31,
+		0x3F, 0x2B, 0x17, 0x03, 0x0E, 0x1A, 0x26, 0x32,
+		0x3A, 0x2E, 0x12, 0x06, 0x0B, 0x1F, 0x23, 0x37,
+		0x39, 0x2D, 0x11, 0x05, 0x08, 0x1C, 0x20, 0x34,
+		0x28, 0x3C, 0x00, 0x14, 0x19, 0x0D, 0x31, 0x25,
+		0x2B, 0x3F, 0x03, 0x17, 0x1A, 0x0E, 0x32, 0x26,
+		0x2E, 0x3A, 0x06, 0x12, 0x1F, 0x0B, 0x37, 0x23,
+		0x2D, 0x39, 0x05, 0x11, 0x1C, 0x08, 0x34, 0x20,
+		0x24, 0x30, 0x0C, 0x18, 0x15, 0x01, 0x3D, 0x29,
+		0x27, 0x33, 0x0F, 0x1B, 0x16, 0x02, 0x3E, 0x2A,
+		0x22, 0x36, 0x0A, 0x1E, 0x13, 0x07, 0x3B, 0x2F,
+		0x21, 0x35, 0x09, 0x1D, 0x10, 0x04, 0x38, 0x2C,
+	},
+	{6, 0x2F, false}: {
+		0x00, 0x2F, 0x31, 0x1E, 0x0D, 0x22, 0x3C, 0x13,
+		0x1A, 0x35, 0x2B, 0x04, 0x17, 0x38, 0x26, 0x09,
+		0x34, 0x1B, 0x05, 0x2A, 0x39, 0x16, 0x08, 0x27,
+		0x2E, 0x01, 0x1F, 0x30, 0x23, 0x0C, 0x12, 0x3D,
+		0x07, 0x28, 0x36, 0x19, 0x0A, 0x25, 0x3B, 0x14,
+		0x1D, 0x32, 0x2C, 0x03, 0x10, 0x3F, 0x21, 0x0E,
+		0x33, 0x1C, 0x02, 0x2D, 0x3E, 0x11, 0x0F, 0x20,
+		0x29, 0x06, 0x18, 0x37, 0x24, 0x0B, 0x15, 0x3A,
+		0x0E, 0x21, 0x3F, 0x10, 0x03, 0x2C, 0x32, 0x1D,
+		0x14, 0x3B, 0x25, 0x0A, 0x19, 0x36, 0x28, 0x07,
+		0x3A, 0x15, 0x0B, 0x24, 0x37, 0x18, 0x06, 0x29,
+		0x20, 0x0F, 0x11, 0x3E, 0x2D, 0x02, 0x1C, 0x33,
+		0x09, 0x26, 0x38, 0x17, 0x04, 0x2B, 0x35, 0x1A,
+		0x13, 0x3C, 0x22, 0x0D, 0x1E, 0x31, 0x2F, 0x00,
+		0x3D, 0x12, 0x0C, 0x23, 0x30, 0x1F, 0x01, 0x2E,
+		0x27, 0x08, 0x16, 0x39, 0x2A, 0x05, 0x1B, 0x34,
+		0x1C, 0x33, 0x2D, 0x02, 0x11, 0x3E, 0x20, 0x0F,
+		0x06, 0x29, 0x37, 0x18, 0x0B, 0x24, 0x3A, 0x15,
+		0x28, 0x07, 0x19, 0x36, 0x25, 0x0A, 0x14, 0x3B,
+		0x32, 0x1D, 0x03, 0x2C, 0x3F, 0x10, 0x0E, 0x21,
+		0x1B, 0x34, 0x2A, 0x05, 0x16, 0x39, 0x27, 0x08,
+		0x01, 0x2E, 0x30, 0x1F, 0x0C, 0x23, 0x3D, 0x12,
+		0x2F, 0x00, 0x1E, 0x31, 0x22, 0x0D, 0x13, 0x3C,
+		0x35, 0x1A, 0x04, 0x2B, 0x38, 0x17, 0x09, 0x26,
+		0x12, 0x3D, 0x23, 0x0C, 0x1F, 0x30, 0x2E, 0x01,
+		0x08, 0x27, 0x39, 0x16, 0x05, 0x2A, 0x34, 0x1B,
+		0x26, 0x09, 0x17, 0x38, 0x2B, 0x04, 0x1A, 0x35,
+		0x3C, 0x13, 0x0D, 0x22, 0x31, 0x1E, 0x00, 0x2F,
+		0x15, 0x3A, 0x24, 0x0B, 0x18, 0x37, 0x29, 0x06,
+		0x0F, 0x20, 0x3E, 0x11, 0x02, 0x2D, 0x33, 0x1C,
+		0x21, 0x0E, 0x10, 0x3F, 0x2C, 0x03, 0x1D, 0x32,
+		0x3B, 0x14, 0x0A, 0x25, 0x36, 0x19, 0x07, 0x28,
+	},
+	{7, 0x9, false}: {
+		0x00, 0x09, 0x12, 0x1B, 0x24, 0x2D, 0x36, 0x3F,
+		0x48, 0x41, 0x5A, 0x53, 0x6C, 0x65, 0x7E, 0x77,
+		0x19, 0x10, 0x0B, 0x02, 0x3D, 0x34, 0x2F, 0x26,
+		0x51, 0x58, 0x43, 0x4A, 0x75, 0x7C, 0x67, 0x6E,
+		0x32, 0x3B, 0x20, 0x29, 0x16, 0x1F, 0x04, 0x0D,
+		0x7A, 0x73, 0x68, 0x61, 0x5E, 0x57, 0x4C, 0x45,
+		0x2B, 0x22, 0x39, 0x30, 0x0F, 0x06, 0x1D, 0x14,
+		0x63, 0x6A, 0x71, 0x78, 0x47, 0x4E, 0x55, 0x5C,
+		0x64, 0x6D, 0x76, 0x7F, 0x40, 0x49, 0x52, 0x5B,
+		0x2C, 0x25, 0x3E, 0x37, 0x08, 0x01, 0x1A, 0x13,
+		0x7D, 0x74, 0x6F, 0x66, 0x59, 0x50, 0x4B, 0x42,
+		0x35, 0x3C, 0x27, 0x2E, 0x11, 0x18, 0x03, 0x0A,
+		0x56, 0x5F, 0x44, 0x4D, 0x72, 0x7B, 0x60, 0x69,
+		0x1E, 0x17, 0x0C, 0x05, 0x3A, 0x33, 0x28, 0x21,
+		0x4F, 0x46, 0x5D, 0x54, 0x6B, 0x62, 0x79, 0x70,
+		0x07, 0x0E, 0x15, 0x1C, 0x23, 0x2A, 0x31, 0x38,
+		0x41, 0x48, 0x53, 0x5A, 0x65, 0x6C, 0x77, 0x7E,
+		0x09, 0x00, 0x1B, 0x12, 0x2D, 0x24, 0x3F, 0x36,
+		0x58, 0x51, 0x4A, 0x43, 0x7C, 0x75, 0x6E, 0x67,
+		0x10, 0x19, 0x02, 0x0B, 0x34, 0x3D, 0x26, 0x2F,
+		0x73, 0x7A, 0x61, 0x68, 0x57, 0x5E, 0x45, 0x4C,
+		0x3B, 0x32, 0x29, 0x20, 0x1F, 0x16, 0x0D, 0x04,
+		0x6A, 0x63, 0x78, 0x71, 0x4E, 0x47, 0x5C, 0x55,
+		0x22, 0x2B, 0x30, 0x39, 0x06, 0x0F, 0x14, 0x1D,
+		0x25, 0x2C, 0x37, 0x3E, 0x01, 0x08, 0x13, 0x1A,
+		0x6D, 0x64, 0x7F, 0x76, 0x49, 0x40, 0x5B, 0x52,
+		0x3C, 0x35, 0x2E, 0x27, 0x18, 0x11, 0x0A, 0x03,
+		0x74, 0x7D, 0x66, 0x6F, 0x50, 0x59, 0x42, 0x4B,
+		0x17, 0x1E, 0x05, 0x0C, 0x33, 0x3A, 0x21, 0x28,
+		0x5F, 0x56, 0x4D, 0x44, 0x7B, 0x72, 0x69, 0x60,
+		0x0E, 0x07, 0x1C, 0x15, 0x2A, 0x23, 0x38, 0x31,
+		0x46, 0x4F, 0x54, 0x5D, 0x62, 0x6B, 0x70, 0x79,
+	},
+	{7, 0x4F, true}: {
+		0x00, 0x40, 0x73, 0x33, 0x15, 0x55, 0x66, 0x26,
+		0x2A, 0x6A, 0x59, 0x19, 0x3F, 0x7F, 0x4C, 0x0C,
+		0x54, 0x14, 0x27, 0x67, 0x41, 0x01, 0x32, 0x72,
+		0x7E, 0x3E, 0x0D, 0x4D, 0x6B, 0x2B, 0x18, 0x58,
+		0x5B, 0x1B, 0x28, 0x68, 0x4E, 0x0E, 0x3D, 0x7D,
+		0x71, 0x31, 0x02, 0x42, 0x64, 0x24, 0x17, 0x57,
+		0x0F, 0x4F, 0x7C, 0x3C, 0x1A, 0x5A, 0x69, 0x29,
+		0x25, 0x65, 0x56, 0x16, 0x30, 0x70, 0x43, 0x03,
+		0x45, 0x05, 0x36, 0x76, 0x50, 0x10, 0x23, 0x63,
+		0x6F, 0x2F, 0x1C, 0x5C, 0x7A, 0x3A, 0x09, 0x49,
+		0x11, 0x51, 0x62, 0x22, 0x04, 0x44, 0x77, 0x37,
+		0x3B, 0x7B, 0x48, 0x08, 0x2E, 0x6E, 0x5D, 0x1D,
+		0x1E, 0x5E, 0x6D, 0x2D, 0x0B, 0x4B, 0x78, 0x38,
+		0x34, 0x74, 0x47, 0x07, 0x21, 0x61, 0x52, 0x12,
+		0x4A, 0x0A, 0x39, 0x79, 0x5F, 0x1F, 0x2C, 0x6C,
+		0x60, 0x20, 0x13, 0x53, 0x75, 0x35, 0x06, 0x46,
+		0x79, 0x39, 0x0A, 0x4A, 0x6C, 0x2C, 0x1F, 0x5F,
+		0x53, 0x13, 0x20, 0x60, 0x46, 0x06, 0x35, 0x75,
+		0x2D, 0x6D, 0x5E, 0x1E, 0x38, 0x78, 0x4B, 0x0B,
+		0x07, 0x47, 0x74, 0x34, 0x12, 0x52, 0x61, 0x21,
+		0x22, 0x62, 0x51, 0x11, 0x37, 0x77, 0x44, 0x04,
+		0x08, 0x48, 0x7B, 0x3B, 0x1D, 0x5D, 0x6E, 0x2E,
+		0x76, 0x36, 0x05, 0x45, 0x63, 0x23, 0x10, 0x50,
+		0x5C, 0x1C, 0x2F, 0x6F, 0x49, 0x09, 0x3A, 0x7A,
+		0x3C, 0x7C, 0x4F, 0x0F, 0x29, 0x69, 0x5A, 0x1A,
+		0x16, 0x56, 0x65, 0x25, 0x03, 0x43, 0x70, 0x30,
+		0x68, 0x28, 0x1B, 0x5B, 0x7D, 0x3D, 0x0E, 0x4E,
+		0x42, 0x02, 0x31, 0x71, 0x57, 0x17, 0x24, 0x64,
+		0x67, 0x27, 0x14, 0x54, 0x72, 0x32, 0x01, 0x41,
+		0x4D, 0x0D, 0x3E, 0x7E, 0x58, 0x18, 0x2B, 0x6B,
+		0x33, 0x73, 0x40, 0x00, 0x26, 0x66, 0x55, 0x15,
+		0x19, 0x59, 0x6A, 0x2A, 0x0C, 0x4C, 0x7F, 0x3F,
+	},
+	{7, 0x45, false}: {
+		0x00, 0x45, 0x4F, 0x0A, 0x5B, 0x1E, 0x14, 0x51,
+		0x73, 0x36, 0x3C, 0x79, 0x28, 0x6D, 0x67, 0x22,
+		0x23, 0x66, 0x6C, 0x29, 0x78, 0x3D, 0x37, 0x72,
+		0x50, 0x15, 0x1F, 0x5A, 0x0B, 0x4E, 0x44, 0x01,
+		0x46, 0x03, 0x09, 0x4C, 0x1D, 0x58, 0x52, 0x17,
+		0x35, 0x70, 0x7A, 0x3F, 0x6E, 0x2B, 0x21, 0x64,
+		0x65, 0x20, 0x2A, 0x6F, 0x3E, 0x7B, 0x71, 0x34,
+		0x16, 0x53, 0x59, 0x1C, 0x4D, 0x08, 0x02, 0x47,
+		0x49, 0x0C, 0x06, 0x43, 0x12, 0x57, 0x5D, 0x18,
+		0x3A, 0x7F, 0x75, 0x30, 0x61, 0x24, 0x2E, 0x6B,
+		0x6A, 0x2F, 0x25, 0x60, 0x31, 0x74, 0x7E, 0x3B,
+		0x19, 0x5C, 0x56, 0x13, 0x42, 0x07, 0x0D, 0x48,
+		0x0F, 0x4A, 0x40, 0x05, 0x54, 0x11, 0x1B, 0x5E,
+		0x7C, 0x39, 0x33, 0x76, 0x27, 0x62, 0x68, 0x2D,
+		0x2C, 0x69, 0x63, 0x26, 0x77, 0x32, 0x38, 0x7D,
+		0x5F, 0x1A, 0x10, 0x55, 0x04, 0x41, 0x4B, 0x0E,
+		0x57, 0x12, 0x18, 0x5D, 0x0C, 0x49, 0x43, 0x06,
+		0x24, 0x61, 0x6B, 0x2E, 0x7F, 0x3A, 0x30, 0x75,
+		0x74, 0x31, 0x3B, 0x7E, 0x2F, 0x6A, 0x60, 0x25,
+		0x07, 0x42, 0x48, 0x0D, 0x5C, 0x19, 0x13, 0x56,
+		0x11, 0x54, 0x5E, 0x1B, 0x4A, 0x0F, 0x05, 0x40,
+		0x62, 0x27, 0x2D, 0x68, 0x39, 0x7C, 0x76, 0x33,
+		0x32, 0x77, 0x7D, 0x38, 0x69, 0x2C, 0x26, 0x63,
+		0x41, 0x04, 0x0E, 0x4B, 0x1A, 0x5F, 0x55, 0x10,
+		0x1E, 0x5B, 0x51, 0x14, 0x45, 0x00, 0x0A, 0x4F,
+		0x6D, 0x28, 0x22, 0x67, 0x36, 0x73, 0x79, 0x3C,
+		0x3D, 0x78, 0x72, 0x37, 0x66, 0x23, 0x29, 0x6C,
+		0x4E, 0x0B, 0x01, 0x44, 0x15, 0x50, 0x5A, 0x1F,
+		0x58, 0x1D, 0x17, 0x52, 0x03, 0x46, 0x4C, 0x09,
+		0x2B, 0x6E, 0x64, 0x21, 0x70, 0x35, 0x3F, 0x7A,
+		0x7B, 0x3E, 0x34, 0x71, 0x20, 0x65, 0x6F, 0x2A,
+		0x08, 0x4D, 0x47, 0x02, 0x53, 0x16, 0x1C, 0x59,
+	},
+	{8, 0x2F, false}: {
+		0x00, 0x2F, 0x5E, 0x71, 0xBC, 0x93, 0xE2, 0xCD,
+		0x57, 0x78, 0x09, 0x26, 0xEB, 0xC4, 0xB5, 0x9A,
+		0xAE, 0x81, 0xF0, 0xDF, 0x12, 0x3D, 0x4C, 0x63,
+		0xF9, 0xD6, 0xA7, 0x88, 0x45, 0x6A, 0x1B, 0x34,
+		0x73, 0x5C, 0x2D, 0x02, 0xCF, 0xE0, 0x91, 0xBE,
+		0x24, 0x0B, 0x7A, 0x55, 0x98, 0xB7, 0xC6, 0xE9,
+		0xDD, 0xF2, 0x83, 0xAC, 0x61, 0x4E, 0x3F, 0x10,
+		0x8A, 0xA5, 0xD4, 0xFB, 0x36, 0x19, 0x68, 0x47,
+		0xE6, 0xC9, 0xB8, 0x97, 0x5A, 0x75, 0x04, 0x2B,
+		0xB1, 0x9E, 0xEF, 0xC0, 0x0D, 0x22, 0x53, 0x7C,
+		0x48, 0x67, 0x16, 0x39, 0xF4, 0xDB, 0xAA, 0x85,
+		0x1F, 0x30, 0x41, 0x6E, 0xA3, 0x8C, 0xFD, 0xD2,
+		0x95, 0xBA, 0xCB, 0xE4, 0x29, 0x06, 0x77, 0x58,
+		0xC2, 0xED, 0x9C, 0xB3, 0x7E, 0x51, 0x20, 0x0F,
+		0x3B, 0x14, 0x65, 0x4A, 0x87, 0xA8, 0xD9, 0xF6,
+		0x6C, 0x43, 0x32, 0x1D, 0xD0, 0xFF, 0x8E, 0xA1,
+		0xE3, 0xCC, 0xBD, 0x92, 0x5F, 0x70, 0x01, 0x2E,
+		0xB4, 0x9B, 0xEA, 0xC5, 0x08, 0x27, 0x56, 0x79,
+		0x4D, 0x62, 0x13, 0x3C, 0xF1, 0xDE, 0xAF, 0x80,
+		0x1A, 0x35, 0x44, 0x6B, 0xA6, 0x89, 0xF8, 0xD7,
+		0x90, 0xBF, 0xCE, 0xE1, 0x2C, 0x03, 0x72, 0x5D,
+		0xC7, 0xE8, 0x99, 0xB6, 0x7B, 0x54, 0x25, 0x0A,
+		0x3E, 0x11, 0x60, 0x4F, 0x82, 0xAD, 0xDC, 0xF3,
+		0x69, 0x46, 0x37, 0x18, 0xD5, 0xFA, 0x8B, 0xA4,
+		0x05, 0x2A, 0x5B, 0x74, 0xB9, 0x96, 0xE7, 0xC8,
+		0x52, 0x7D, 0x0C, 0x23, 0xEE, 0xC1, 0xB0, 0x9F,
+		0xAB, 0x84, 0xF5, 0xDA, 0x17, 0x38, 0x49, 0x66,
+		0xFC, 0xD3, 0xA2, 0x8D, 0x40, 0x6F, 0x1E, 0x31,
+		0x76, 0x59, 0x28, 0x07, 0xCA, 0xE5, 0x94, 0xBB,
+		0x21, 0x0E, 0x7F, 0x50, 0x9D, 0xB2, 0xC3, 0xEC,
+		0xD8, 0xF7, 0x86, 0xA9, 0x64, 0x4B, 0x3A, 0x15,
+		0x8F, 0xA0, 0xD1, 0xFE, 0x33, 0x1C, 0x6D, 0x42,
+	},
+	{8, 0xA7, true}: {
+		0x00, 0x6B, 0xD6, 0xBD, 0x67, 0x0C, 0xB1, 0xDA,
+		0xCE, 0xA5, 0x18, 0x73, 0xA9, 0xC2, 0x7F, 0x14,
+		0x57, 0x3C, 0x81, 0xEA, 0x30, 0x5B, 0xE6, 0x8D,
+		0x99, 0xF2, 0x4F, 0x24, 0xFE, 0x95, 0x28, 0x43,
+		0xAE, 0xC5, 0x78, 0x13, 0xC9, 0xA2, 0x1F, 0x74,
+		0x60, 0x0B, 0xB6, 0xDD, 0x07, 0x6C, 0xD1, 0xBA,
+		0xF9, 0x92, 0x2F, 0x44, 0x9E, 0xF5, 0x48, 0x23,
+		0x37, 0x5C, 0xE1, 0x8A, 0x50, 0x3B, 0x86, 0xED,
+		0x97, 0xFC, 0x41, 0x2A, 0xF0, 0x9B, 0x26, 0x4D,
+		0x59, 0x32, 0x8F, 0xE4, 0x3E, 0x55, 0xE8, 0x83,
+		0xC0, 0xAB, 0x16, 0x7D, 0xA7, 0xCC, 0x71, 0x1A,
+		0x0E, 0x65, 0xD8, 0xB3, 0x69, 0x02, 0xBF, 0xD4,
+		0x39, 0x52, 0xEF, 0x84, 0x5E, 0x35, 0x88, 0xE3,
+		0xF7, 0x9C, 0x21, 0x4A, 0x90, 0xFB, 0x46, 0x2D,
+		0x6E, 0x05, 0xB8, 0xD3, 0x09, 0x62, 0xDF, 0xB4,
+		0xA0, 0xCB, 0x76, 0x1D, 0xC7, 0xAC, 0x11, 0x7A,
+		0xE5, 0x8E, 0x33, 0x58, 0x82, 0xE9, 0x54, 0x3F,
+		0x2B, 0x40, 0xFD, 0x96, 0x4C, 0x27, 0x9A, 0xF1,
+		0xB2, 0xD9, 0x64, 0x0F, 0xD5, 0xBE, 0x03, 0x68,
+		0x7C, 0x17, 0xAA, 0xC1, 0x1B, 0x70, 0xCD, 0xA6,
+		0x4B, 0x20, 0x9D, 0xF6, 0x2C, 0x47, 0xFA, 0x91,
+		0x85, 0xEE, 0x53, 0x38, 0xE2, 0x89, 0x34, 0x5F,
+		0x1C, 0x77, 0xCA, 0xA1, 0x7B, 0x10, 0xAD, 0xC6,
+		0xD2, 0xB9, 0x04, 0x6F, 0xB5, 0xDE, 0x63, 0x08,
+		0x72, 0x19, 0xA4, 0xCF, 0x15, 0x7E, 0xC3, 0xA8,
+		0xBC, 0xD7, 0x6A, 0x01, 0xDB, 0xB0, 0x0D, 0x66,
+		0x25, 0x4E, 0xF3, 0x98, 0x42, 0x29, 0x94, 0xFF,
+		0xEB, 0x80, 0x3D, 0x56, 0x8C, 0xE7, 0x5A, 0x31,
+		0xDC, 0xB7, 0x0A, 0x61, 0xBB, 0xD0, 0x6D, 0x06,
+		0x12, 0x79, 0xC4, 0xAF, 0x75, 0x1E, 0xA3, 0xC8,
+		0x8B, 0xE0, 0x5D, 0x36, 0xEC, 0x87, 0x3A, 0x51,
+		0x45, 0x2E, 0x93, 0xF8, 0x22, 0x49, 0xF4, 0x9F,
+	},
+	{8, 0x9B, false}: {
+		0x00, 0x9B, 0xAD, 0x36, 0xC1, 0x5A, 0x6C, 0xF7,
+		0x19, 0x82, 0xB4, 0x2F, 0xD8, 0x43, 0x75, 0xEE,
+		0x32, 0xA9, 0x9F, 0x04, 0xF3, 0x68, 0x5E, 0xC5,
+		0x2B, 0xB0, 0x86, 0x1D, 0xEA, 0x71, 0x47, 0xDC,
+		0x64, 0xFF, 0xC9, 0x52, 0xA5, 0x3E, 0x08, 0x93,
+		0x7D, 0xE6, 0xD0, 0x4B, 0xBC, 0x27, 0x11, 0x8A,
+		0x56, 0xCD, 0xFB, 0x60, 0x97, 0x0C, 0x3A, 0xA1,
+		0x4F, 0xD4, 0xE2, 0x79, 0x8E, 0x15, 0x23, 0xB8,
+		0xC8, 0x53, 0x65, 0xFE, 0x09, 0x92, 0xA4, 0x3F,
+		0xD1, 0x4A, 0x7C, 0xE7, 0x10, 0x8B, 0xBD, 0x26,
+		0xFA, 0x61, 0x57, 0xCC, 0x3B, 0xA0, 0x96, 0x0D,
+		0xE3, 0x78, 0x4E, 0xD5, 0x22, 0xB9, 0x8F, 0x14,
+		0xAC, 0x37, 0x01, 0x9A, 0x6D, 0xF6, 0xC0, 0x5B,
+		0xB5, 0x2E, 0x18, 0x83, 0x74, 0xEF, 0xD9, 0x42,
+		0x9E, 0x05, 0x33, 0xA8, 0x5F, 0xC4, 0xF2, 0x69,
+		0x87, 0x1C, 0x2A, 0xB1, 0x46, 0xDD, 0xEB, 0x70,
+		0x0B, 0x90, 0xA6, 0x3D, 0xCA, 0x51, 0x67, 0xFC,
+		0x12, 0x89, 0xBF, 0x24, 0xD3, 0x48, 0x7E, 0xE5,
+		0x39, 0xA2, 0x94, 0x0F, 0xF8, 0x63, 0x55, 0xCE,
+		0x20, 0xBB, 0x8D, 0x16, 0xE1, 0x7A, 0x4C, 0xD7,
+		0x6F, 0xF4, 0xC2, 0x59, 0xAE, 0x35, 0x03, 0x98,
+		0x76, 0xED, 0xDB, 0x40, 0xB7, 0x2C, 0x1A, 0x81,
+		0x5D, 0xC6, 0xF0, 0x6B, 0x9C, 0x07, 0x31, 0xAA,
+		0x44, 0xDF, 0xE9, 0x72, 0x85, 0x1E, 0x28, 0xB3,
+		0xC3, 0x58, 0x6E, 0xF5, 0x02, 0x99, 0xAF, 0x34,
+		0xDA, 0x41, 0x77, 0xEC, 0x1B, 0x80, 0xB6, 0x2D,
+		0xF1, 0x6A, 0x5C, 0xC7, 0x30, 0xAB, 0x9D, 0x06,
+		0xE8, 0x73, 0x45, 0xDE, 0x29, 0xB2, 0x84, 0x1F,
+		0xA7, 0x3C, 0x0A, 0x91, 0x66, 0xFD, 0xCB, 0x50,
+		0xBE, 0x25, 0x13, 0x88, 0x7F, 0xE4, 0xD2, 0x49,
+		0x95, 0x0E, 0x38, 0xA3, 0x54, 0xCF, 0xF9, 0x62,
+		0x8C, 0x17, 0x21, 0xBA, 0x4D, 0xD6, 0xE0, 0x7B,
+	},
+	{8, 0x39, true}: {
+		0x00, 0x72, 0xE4, 0x96, 0xF1, 0x83, 0x15, 0x67,
+		0xDB, 0xA9, 0x3F, 0x4D, 0x2A, 0x58, 0xCE, 0xBC,
+		0x8F, 0xFD, 0x6B, 0x19, 0x7E, 0x0C, 0x9A, 0xE8,
+		0x54, 0x26, 0xB0, 0xC2, 0xA5, 0xD7, 0x41, 0x33,
+		0x27, 0x55, 0xC3, 0xB1, 0xD6, 0xA4, 0x32, 0x40,
+		0xFC, 0x8E, 0x18, 0x6A, 0x0D, 0x7F, 0xE9, 0x9B,
+		0xA8, 0xDA, 0x4C, 0x3E, 0x59, 0x2B, 0xBD, 0xCF,
+		0x73, 0x01, 0x97, 0xE5, 0x82, 0xF0, 0x66, 0x14,
+		0x4E, 0x3C, 0xAA, 0xD8, 0xBF, 0xCD, 0x5B, 0x29,
+		0x95, 0xE7, 0x71, 0x03, 0x64, 0x16, 0x80, 0xF2,
+		0xC1, 0xB3, 0x25, 0x57, 0x30, 0x42, 0xD4, 0xA6,
+		0x1A, 0x68, 0xFE, 0x8C, 0xEB, 0x99, 0x0F, 0x7D,
+		0x69, 0x1B, 0x8D, 0xFF, 0x98, 0xEA, 0x7C, 0x0E,
+		0xB2, 0xC0, 0x56, 0x24, 0x43, 0x31, 0xA7, 0xD5,
+		0xE6, 0x94, 0x02, 0x70, 0x17, 0x65, 0xF3, 0x81,
+		0x3D, 0x4F, 0xD9, 0xAB, 0xCC, 0xBE, 0x28, 0x5A,
+		0x9C, 0xEE, 0x78, 0x0A, 0x6D, 0x1F, 0x89, 0xFB,
+		0x47, 0x35, 0xA3, 0xD1, 0xB6, 0xC4, 0x52, 0x20,
+		0x13, 0x61, 0xF7, 0x85, 0xE2, 0x90, 0x06, 0x74,
+		0xC8, 0xBA, 0x2C, 0x5E, 0x39, 0x4B, 0xDD, 0xAF,
+		0xBB, 0xC9, 0x5F, 0x2D, 0x4A, 0x38, 0xAE, 0xDC,
+		0x60, 0x12, 0x84, 0xF6, 0x91, 0xE3, 0x75, 0x07,
+		0x34, 0x46, 0xD0, 0xA2, 0xC5, 0xB7, 0x21, 0x53,
+		0xEF, 0x9D, 0x0B, 0x79, 0x1E, 0x6C, 0xFA, 0x88,
+		0xD2, 0xA0, 0x36, 0x44, 0x23, 0x51, 0xC7, 0xB5,
+		0x09, 0x7B, 0xED, 0x9F, 0xF8, 0x8A, 0x1C, 0x6E,
+		0x5D, 0x2F, 0xB9, 0xCB, 0xAC, 0xDE, 0x48, 0x3A,
+		0x86, 0xF4, 0x62, 0x10, 0x77, 0x05, 0x93, 0xE1,
+		0xF5, 0x87, 0x11, 0x63, 0x04, 0x76, 0xE0, 0x92,
+		0x2E, 0x5C, 0xCA, 0xB8, 0xDF, 0xAD, 0x3B, 0x49,
+		0x7A, 0x08, 0x9E, 0xEC, 0x8B, 0xF9, 0x6F, 0x1D,
+		0xA1, 0xD3, 0x45, 0x37, 0x50, 0x22, 0xB4, 0xC6,
+	},
+	{8, 0xD5, false}: {
+		0x00, 0xD5, 0x7F, 0xAA, 0xFE, 0x2B, 0x81, 0x54,
+		0x29, 0xFC, 0x56, 0x83, 0xD7, 0x02, 0xA8, 0x7D,
+		0x52, 0x87, 0x2D, 0xF8, 0xAC, 0x79, 0xD3, 0x06,
+		0x7B, 0xAE, 0x04, 0xD1, 0x85, 0x50, 0xFA, 0x2F,
+		0xA4, 0x71, 0xDB, 0x0E, 0x5A, 0x8F, 0x25, 0xF0,
+		0x8D, 0x58, 0xF2, 0x27, 0x73, 0xA6, 0x0C, 0xD9,
+		0xF6, 0x23, 0x89, 0x5C, 0x08, 0xDD, 0x77, 0xA2,
+		0xDF, 0x0A, 0xA0, 0x75, 0x21, 0xF4, 0x5E, 0x8B,
+		0x9D, 0x48, 0xE2, 0x37, 0x63, 0xB6, 0x1C, 0xC9,
+		0xB4, 0x61, 0xCB, 0x1E, 0x4A, 0x9F, 0x35, 0xE0,
+		0xCF, 0x1A, 0xB0, 0x65, 0x31, 0xE4, 0x4E, 0x9B,
+		0xE6, 0x33, 0x99, 0x4C, 0x18, 0xCD, 0x67, 0xB2,
+		0x39, 0xEC, 0x46, 0x93, 0xC7, 0x12, 0xB8, 0x6D,
+		0x10, 0xC5, 0x6F, 0xBA, 0xEE, 0x3B, 0x91, 0x44,
+		0x6B, 0xBE, 0x14, 0xC1, 0x95, 0x40, 0xEA, 0x3F,
+		0x42, 0x97, 0x3D, 0xE8, 0xBC, 0x69, 0xC3, 0x16,
+		0xEF, 0x3A, 0x90, 0x45, 0x11, 0xC4, 0x6E, 0xBB,
+		0xC6, 0x13, 0xB9, 0x6C, 0x38, 0xED, 0x47, 0x92,
+		0xBD, 0x68, 0xC2, 0x17, 0x43, 0x96, 0x3C, 0xE9,
+		0x94, 0x41, 0xEB, 0x3E, 0x6A, 0xBF, 0x15, 0xC0,
+		0x4B, 0x9E, 0x34, 0xE1, 0xB5, 0x60, 0xCA, 0x1F,
+		0x62, 0xB7, 0x1D, 0xC8, 0x9C, 0x49, 0xE3, 0x36,
+		0x19, 0xCC, 0x66, 0xB3, 0xE7, 0x32, 0x98, 0x4D,
+		0x30, 0xE5, 0x4F, 0x9A, 0xCE, 0x1B, 0xB1, 0x64,
+		0x72, 0xA7, 0x0D, 0xD8, 0x8C, 0x59, 0xF3, 0x26,
+		0x5B, 0x8E, 0x24, 0xF1, 0xA5, 0x70, 0xDA, 0x0F,
+		0x20, 0xF5, 0x5F, 0x8A, 0xDE, 0x0B, 0xA1, 0x74,
+		0x09, 0xDC, 0x76, 0xA3, 0xF7, 0x22, 0x88, 0x5D,
+		0xD6, 0x03, 0xA9, 0x7C, 0x28, 0xFD, 0x57, 0x82,
+		0xFF, 0x2A, 0x80, 0x55, 0x01, 0xD4, 0x7E, 0xAB,
+		0x84, 0x51, 0xFB, 0x2E, 0x7A, 0xAF, 0x05, 0xD0,
+		0xAD, 0x78, 0xD2, 0x07, 0x53, 0x86, 0x2C, 0xF9,
+	},
+	{8, 0x1D, false}: {
+		0x00, 0x1D, 0x3A, 0x27, 0x74, 0x69, 0x4E, 0x53,
+		0xE8, 0xF5, 0xD2, 0xCF, 0x9C, 0x81, 0xA6, 0xBB,
+		0xCD, 0xD0, 0xF7, 0xEA, 0xB9, 0xA4, 0x83, 0x9E,
+		0x25, 0x38, 0x1F, 0x02, 0x51, 0x4C, 0x6B, 0x76,
+		0x87, 0x9A, 0xBD, 0xA0, 0xF3, 0xEE, 0xC9, 0xD4,
+		0x6F, 0x72, 0x55, 0x48, 0x1B, 0x06, 0x21, 0x3C,
+		0x4A, 0x57, 0x70, 0x6D, 0x3E, 0x23, 0x04, 0x19,
+		0xA2, 0xBF, 0x98, 0x85, 0xD6, 0xCB, 0xEC, 0xF1,
+		0x13, 0x0E, 0x29, 0x34, 0x67, 0x7A, 0x5D, 0x40,
+		0xFB, 0xE6, 0xC1, 0xDC, 0x8F, 0x92, 0xB5, 0xA8,
+		0xDE, 0xC3, 0xE4, 0xF9, 0xAA, 0xB7, 0x90, 0x8D,
+		0x36, 0x2B, 0x0C, 0x11, 0x42, 0x5F, 0x78, 0x65,
+		0x94, 0x89, 0xAE, 0xB3, 0xE0, 0xFD, 0xDA, 0xC7,
+		0x7C, 0x61, 0x46, 0x5B, 0x08, 0x15, 0x32, 0x2F,
+		0x59, 0x44, 0x63, 0x7E, 0x2D, 0x30, 0x17, 0x0A,
+		0xB1, 0xAC, 0x8B, 0x96, 0xC5, 0xD8, 0xFF, 0xE2,
+		0x26, 0x3B, 0x1C, 0x01, 0x52, 0x4F, 0x68, 0x75,
+		0xCE, 0xD3, 0xF4, 0xE9, 0xBA, 0xA7, 0x80, 0x9D,
+		0xEB, 0xF6, 0xD1, 0xCC, 0x9F, 0x82, 0xA5, 0xB8,
+		0x03, 0x1E, 0x39, 0x24, 0x77, 0x6A, 0x4D, 0x50,
+		0xA1, 0xBC, 0x9B, 0x86, 0xD5, 0xC8, 0xEF, 0xF2,
+		0x49, 0x54, 0x73, 0x6E, 0x3D, 0x20, 0x07, 0x1A,
+		0x6C, 0x71, 0x56, 0x4B, 0x18, 0x05, 0x22, 0x3F,
+		0x84, 0x99, 0xBE, 0xA3, 0xF0, 0xED, 0xCA, 0xD7,
+		0x35, 0x28, 0x0F, 0x12, 0x41, 0x5C, 0x7B, 0x66,
+		0xDD, 0xC0, 0xE7, 0xFA, 0xA9, 0xB4, 0x93, 0x8E,
+		0xF8, 0xE5, 0xC2, 0xDF, 0x8C, 0x91, 0xB6, 0xAB,
+		0x10, 0x0D, 0x2A, 0x37, 0x64, 0x79, 0x5E, 0x43,
+		0xB2, 0xAF, 0x88, 0x95, 0xC6, 0xDB, 0xFC, 0xE1,
+		0x5A, 0x47, 0x60, 0x7D, 0x2E, 0x33, 0x14, 0x09,
+		0x7F, 0x62, 0x45, 0x58, 0x0B, 0x16, 0x31, 0x2C,
+		0x97, 0x8A, 0xAD, 0xB0, 0xE3, 0xFE, 0xD9, 0xC4,
+	},
+	{8, 0x49, false}: {
+		0x00, 0x49, 0x92, 0xDB, 0x6D, 0x24, 0xFF, 0xB6,
+		0xDA, 0x93, 0x48, 0x01, 0xB7, 0xFE, 0x25, 0x6C,
+		0xFD, 0xB4, 0x6F, 0x26, 0x90, 0xD9, 0x02, 0x4B,
+		0x27, 0x6E, 0xB5, 0xFC, 0x4A, 0x03, 0xD8, 0x91,
+		0xB3, 0xFA, 0x21, 0x68, 0xDE, 0x97, 0x4C, 0x05,
+		0x69, 0x20, 0xFB, 0xB2, 0x04, 0x4D, 0x96, 0xDF,
+		0x4E, 0x07, 0xDC, 0x95, 0x23, 0x6A, 0xB1, 0xF8,
+		0x94, 0xDD, 0x06, 0x4F, 0xF9, 0xB0, 0x6B, 0x22,
+		0x2F, 0x66, 0xBD, 0xF4, 0x42, 0x0B, 0xD0, 0x99,
+		0xF5, 0xBC, 0x67, 0x2E, 0x98, 0xD1, 0x0A, 0x43,
+		0xD2, 0x9B, 0x40, 0x09, 0xBF, 0xF6, 0x2D, 0x64,
+		0x08, 0x41, 0x9A, 0xD3, 0x65, 0x2C, 0xF7, 0xBE,
+		0x9C, 0xD5, 0x0E, 0x47, 0xF1, 0xB8, 0x63, 0x2A,
+		0x46, 0x0F, 0xD4, 0x9D, 0x2B, 0x62, 0xB9, 0xF0,
+		0x61, 0x28, 0xF3, 0xBA, 0x0C, 0x45, 0x9E, 0xD7,
+		0xBB, 0xF2, 0x29, 0x60, 0xD6, 0x9F, 0x44, 0x0D,
+		0x5E, 0x17, 0xCC, 0x85, 0x33, 0x7A, 0xA1, 0xE8,
+		0x84, 0xCD, 0x16, 0x5F, 0xE9, 0xA0, 0x7B, 0x32,
+		0xA3, 0xEA, 0x31, 0x78, 0xCE, 0x87, 0x5C, 0x15,
+		0x79, 0x30, 0xEB, 0xA2, 0x14, 0x5D, 0x86, 0xCF,
+		0xED, 0xA4, 0x7F, 0x36, 0x80, 0xC9, 0x12, 0x5B,
+		0x37, 0x7E, 0xA5, 0xEC, 0x5A, 0x13, 0xC8, 0x81,
+		0x10, 0x59, 0x82, 0xCB, 0x7D, 0x34, 0xEF, 0xA6,
+		0xCA, 0x83, 0x58, 0x11, 0xA7, 0xEE, 0x35, 0x7C,
+		0x71, 0x38, 0xE3, 0xAA, 0x1C, 0x55, 0x8E, 0xC7,
+		0xAB, 0xE2, 0x39, 0x70, 0xC6, 0x8F, 0x54, 0x1D,
+		0x8C, 0xC5, 0x1E, 0x57, 0xE1, 0xA8, 0x73, 0x3A,
+		0x56, 0x1F, 0xC4, 0x8D, 0x3B, 0x72, 0xA9, 0xE0,
+		0xC2, 0x8B, 0x50, 0x19, 0xAF, 0xE6, 0x3D, 0x74,
+		0x18, 0x51, 0x8A, 0xC3, 0x75, 0x3C, 0xE7, 0xAE,
+		0x3F, 0x76, 0xAD, 0xE4, 0x52, 0x1B, 0xC0, 0x89,
+		0xE5, 0xAC, 0x77, 0x3E, 0x88, 0xC1, 0x1A, 0x53,
+	},
+	{8, 0x7, false}: {
+		0x00, 0x07, 0x0E, 0x09, 0x1C, 0x1B, 0x12, 0x15,
+		0x38, 0x3F, 0x36, 0x31, 0x24, 0x23, 0x2A, 0x2D,
+		0x70, 0x77, 0x7E, 0x79, 0x6C, 0x6B, 0x62, 0x65,
+		0x48, 0x4F, 0x46, 0x41, 0x54, 0x53, 0x5A, 0x5D,
+		0xE0, 0xE7, 0xEE, 0xE9, 0xFC, 0xFB, 0xF2, 0xF5,
+		0xD8, 0xDF, 0xD6, 0xD1, 0xC4, 0xC3, 0xCA, 0xCD,
+		0x90, 0x97, 0x9E, 0x99, 0x8C, 0x8B, 0x82, 0x85,
+		0xA8, 0xAF, 0xA6, 0xA1, 0xB4, 0xB3, 0xBA, 0xBD,
+		0xC7, 0xC0, 0xC9, 0xCE, 0xDB, 0xDC, 0xD5, 0xD2,
+		0xFF, 0xF8, 0xF1, 0xF6, 0xE3, 0xE4, 0xED, 0xEA,
+		0xB7, 0xB0, 0xB9, 0xBE, 0xAB, 0xAC, 0xA5, 0xA2,
+		0x8F, 0x88, 0x81, 0x86, 0x93, 0x94, 0x9D, 0x9A,
+		0x27, 0x20, 0x29, 0x2E, 0x3B, 0x3C, 0x35, 0x32,
+		0x1F, 0x18, 0x11, 0x16, 0x03, 0x04, 0x0D, 0x0A,
+		0x57, 0x50, 0x59, 0x5E, 0x4B, 0x4C, 0x45, 0x42,
+		0x6F, 0x68, 0x61, 0x66, 0x73, 0x74, 0x7D, 0x7A,
+		0x89, 0x8E, 0x87, 0x80, 0x95, 0x92, 0x9B, 0x9C,
+		0xB1, 0xB6, 0xBF, 0xB8, 0xAD, 0xAA, 0xA3, 0xA4,
+		0xF9, 0xFE, 0xF7, 0xF0, 0xE5, 0xE2, 0xEB, 0xEC,
+		0xC1, 0xC6, 0xCF, 0xC8, 0xDD, 0xDA, 0xD3, 0xD4,
+		0x69, 0x6E, 0x67, 0x60, 0x75, 0x72, 0x7B, 0x7C,
+		0x51, 0x56, 0x5F, 0x58, 0x4D, 0x4A, 0x43, 0x44,
+		0x19, 0x1E, 0x17, 0x10, 0x05, 0x02, 0x0B, 0x0C,
+		0x21, 0x26, 0x2F, 0x28, 0x3D, 0x3A, 0x33, 0x34,
+		0x4E, 0x49, 0x40, 0x47, 0x52, 0x55, 0x5C, 0x5B,
+		0x76, 0x71, 0x78, 0x7F, 0x6A, 0x6D, 0x64, 0x63,
+		0x3E, 0x39, 0x30, 0x37, 0x22, 0x25, 0x2C, 0x2B,
+		0x06, 0x01, 0x08, 0x0F, 0x1A, 0x1D, 0x14, 0x13,
+		0xAE, 0xA9, 0xA0, 0xA7, 0xB2, 0xB5, 0xBC, 0xBB,
+		0x96, 0x91, 0x98, 0x9F, 0x8A, 0x8D, 0x84, 0x83,
+		0xDE, 0xD9, 0xD0, 0xD7, 0xC2, 0xC5, 0xCC, 0xCB,
+		0xE6, 0xE1, 0xE8, 0xEF, 0xFA, 0xFD, 0xF4, 0xF3,
+	},
+	{8, 0x31, true}: {
+		0x00, 0x5E, 0xBC, 0xE2, 0x61, 0x3F, 0xDD, 0x83,
+		0xC2, 0x9C, 0x7E, 0x20, 0xA3, 0xFD, 0x1F, 0x41,
+		0x9D, 0xC3, 0x21, 0x7F, 0xFC, 0xA2, 0x40, 0x1E,
+		0x5F, 0x01, 0xE3, 0xBD, 0x3E, 0x60, 0x82, 0xDC,
+		0x23, 0x7D, 0x9F, 0xC1, 0x42, 0x1C, 0xFE, 0xA0,
+		0xE1, 0xBF, 0x5D, 0x03, 0x80, 0xDE, 0x3C, 0x62,
+		0xBE, 0xE0, 0x02, 0x5C, 0xDF, 0x81, 0x63, 0x3D,
+		0x7C, 0x22, 0xC0, 0x9E, 0x1D, 0x43, 0xA1, 0xFF,
+		0x46, 0x18, 0xFA, 0xA4, 0x27, 0x79, 0x9B, 0xC5,
+		0x84, 0xDA, 0x38, 0x66, 0xE5, 0xBB, 0x59, 0x07,
+		0xDB, 0x85, 0x67, 0x39, 0xBA, 0xE4, 0x06, 0x58,
+		0x19, 0x47, 0xA5, 0xFB, 0x78, 0x26, 0xC4, 0x9A,
+		0x65, 0x3B, 0xD9, 0x87, 0x04, 0x5A, 0xB8, 0xE6,
+		0xA7, 0xF9, 0x1B, 0x45, 0xC6, 0x98, 0x7A, 0x24,
+		0xF8, 0xA6, 0x44, 0x1A, 0x99, 0xC7, 0x25, 0x7B,
+		0x3A, 0x64, 0x86, 0xD8, 0x5B, 0x05, 0xE7, 0xB9,
+		0x8C, 0xD2, 0x30, 0x6E, 0xED, 0xB3, 0x51, 0x0F,
+		0x4E, 0x10, 0xF2, 0xAC, 0x2F, 0x71, 0x93, 0xCD,
+		0x11, 0x4F, 0xAD, 0xF3, 0x70, 0x2E, 0xCC, 0x92,
+		0xD3, 0x8D, 0x6F, 0x31, 0xB2, 0xEC, 0x0E, 0x50,
+		0xAF, 0xF1, 0x13, 0x4D, 0xCE, 0x90, 0x72, 0x2C,
+		0x6D, 0x33, 0xD1, 0x8F, 0x0C, 0x52, 0xB0, 0xEE,
+		0x32, 0x6C, 0x8E, 0xD0, 0x53, 0x0D, 0xEF, 0xB1,
+		0xF0, 0xAE, 0x4C, 0x12, 0x91, 0xCF, 0x2D, 0x73,
+		0xCA, 0x94, 0x76, 0x28, 0xAB, 0xF5, 0x17, 0x49,
+		0x08, 0x56, 0xB4, 0xEA, 0x69, 0x37, 0xD5, 0x8B,
+		0x57, 0x09, 0xEB, 0xB5, 0x36, 0x68, 0x8A, 0xD4,
+		0x95, 0xCB, 0x29, 0x77, 0xF4, 0xAA, 0x48, 0x16,
+		0xE9, 0xB7, 0x55, 0x0B, 0x88, 0xD6, 0x34, 0x6A,
+		0x2B, 0x75, 0x97, 0xC9, 0x4A, 0x14, 0xF6, 0xA8,
+		0x74, 0x2A, 0xC8, 0x96, 0x15, 0x4B, 0xA9, 0xF7,
+		0xB6, 0xE8, 0x0A, 0x54, 0xD7, 0x89, 0x6B, 0x35,
+	},
+	{8, 0x31, false}: {
+		0x00, 0x31, 0x62, 0x53, 0xC4, 0xF5, 0xA6, 0x97,
+		0xB9, 0x88, 0xDB, 0xEA, 0x7D, 0x4C, 0x1F, 0x2E,
+		0x43, 0x72, 0x21, 0x10, 0x87, 0xB6, 0xE5, 0xD4,
+		0xFA, 0xCB, 0x98, 0xA9, 0x3E, 0x0F, 0x5C, 0x6D,
+		0x86, 0xB7, 0xE4, 0xD5, 0x42, 0x73, 0x20, 0x11,
+		0x3F, 0x0E, 0x5D, 0x6C, 0xFB, 0xCA, 0x99, 0xA8,
+		0xC5, 0xF4, 0xA7, 0x96, 0x01, 0x30, 0x63, 0x52,
+		0x7C, 0x4D, 0x1E, 0x2F, 0xB8, 0x89, 0xDA, 0xEB,
+		0x3D, 0x0C, 0x5F, 0x6E, 0xF9, 0xC8, 0x9B, 0xAA,
+		0x84, 0xB5, 0xE6, 0xD7, 0x40, 0x71, 0x22, 0x13,
+		0x7E, 0x4F, 0x1C, 0x2D, 0xBA, 0x8B, 0xD8, 0xE9,
+		0xC7, 0xF6, 0xA5, 0x94, 0x03, 0x32, 0x61, 0x50,
+		0xBB, 0x8A, 0xD9, 0xE8, 0x7F, 0x4E, 0x1D, 0x2C,
+		0x02, 0x33, 0x60, 0x51, 0xC6, 0xF7, 0xA4, 0x95,
+		0xF8, 0xC9, 0x9A, 0xAB, 0x3C, 0x0D, 0x5E, 0x6F,
+		0x41, 0x70, 0x23, 0x12, 0x85, 0xB4, 0xE7, 0xD6,
+		0x7A, 0x4B, 0x18, 0x29, 0xBE, 0x8F, 0xDC, 0xED,
+		0xC3, 0xF2, 0xA1, 0x90, 0x07, 0x36, 0x65, 0x54,
+		0x39, 0x08, 0x5B, 0x6A, 0xFD, 0xCC, 0x9F, 0xAE,
+		0x80, 0xB1, 0xE2, 0xD3, 0x44, 0x75, 0x26, 0x17,
+		0xFC, 0xCD, 0x9E, 0xAF, 0x38, 0x09, 0x5A, 0x6B,
+		0x45, 0x74, 0x27, 0x16, 0x81, 0xB0, 0xE3, 0xD2,
+		0xBF, 0x8E, 0xDD, 0xEC, 0x7B, 0x4A, 0x19, 0x28,
+		0x06, 0x37, 0x64, 0x55, 0xC2, 0xF3, 0xA0, 0x91,
+		0x47, 0x76, 0x25, 0x14, 0x83, 0xB2, 0xE1, 0xD0,
+		0xFE, 0xCF, 0x9C, 0xAD, 0x3A, 0x0B, 0x58, 0x69,
+		0x04, 0x35, 0x66, 0x57, 0xC0, 0xF1, 0xA2, 0x93,
+		0xBD, 0x8C, 0xDF, 0xEE, 0x79, 0x48, 0x1B, 0x2A,
+		0xC1, 0xF0, 0xA3, 0x92, 0x05, 0x34, 0x67, 0x56,
+		0x78, 0x49, 0x1A, 0x2B, 0xBC, 0x8D, 0xDE, 0xEF,
+		0x82, 0xB3, 0xE0, 0xD1, 0x46, 0x77, 0x24, 0x15,
+		0x3B, 0x0A, 0x59, 0x68, 0xFF, 0xCE, 0x9D, 0xAC,
+	},
+	{8, 0x7, true}: {
+		0x00, 0x91, 0xE3, 0x72, 0x07, 0x96, 0xE4, 0x75,
+		0x0E, 0x9F, 0xED, 0x7C, 0x09, 0x98, 0xEA, 0x7B,
+		0x1C, 0x8D, 0xFF, 0x6E, 0x1B, 0x8A, 0xF8, 0x69,
+		0x12, 0x83, 0xF1, 0x60, 0x15, 0x84, 0xF6, 0x67,
+		0x38, 0xA9, 0xDB, 0x4A, 0x3F, 0xAE, 0xDC, 0x4D,
+		0x36, 0xA7, 0xD5, 0x44, 0x31, 0xA0, 0xD2, 0x43,
+		0x24, 0xB5, 0xC7, 0x56, 0x23, 0xB2, 0xC0, 0x51,
+		0x2A, 0xBB, 0xC9, 0x58, 0x2D, 0xBC, 0xCE, 0x5F,
+		0x70, 0xE1, 0x93, 0x02, 0x77, 0xE6, 0x94, 0x05,
+		0x7E, 0xEF, 0x9D, 0x0C, 0x79, 0xE8, 0x9A, 0x0B,
+		0x6C, 0xFD, 0x8F, 0x1E, 0x6B, 0xFA, 0x88, 0x19,
+		0x62, 0xF3, 0x81, 0x10, 0x65, 0xF4, 0x86, 0x17,
+		0x48, 0xD9, 0xAB, 0x3A, 0x4F, 0xDE, 0xAC, 0x3D,
+		0x46, 0xD7, 0xA5, 0x34, 0x41, 0xD0, 0xA2, 0x33,
+		0x54, 0xC5, 0xB7, 0x26, 0x53, 0xC2, 0xB0, 0x21,
+		0x5A, 0xCB, 0xB9, 0x28, 0x5D, 0xCC, 0xBE, 0x2F,
+		0xE0, 0x71, 0x03, 0x92, 0xE7, 0x76, 0x04, 0x95,
+		0xEE, 0x7F, 0x0D, 0x9C, 0xE9, 0x78, 0x0A, 0x9B,
+		0xFC, 0x6D, 0x1F, 0x8E, 0xFB, 0x6A, 0x18, 0x89,
+		0xF2, 0x63, 0x11, 0x80, 0xF5, 0x64, 0x16, 0x87,
+		0xD8, 0x49, 0x3B, 0xAA, 0xDF, 0x4E, 0x3C, 0xAD,
+		0xD6, 0x47, 0x35, 0xA4, 0xD1, 0x40, 0x32, 0xA3,
+		0xC4, 0x55, 0x27, 0xB6, 0xC3, 0x52, 0x20, 0xB1,
+		0xCA, 0x5B, 0x29, 0xB8, 0xCD, 0x5C, 0x2E, 0xBF,
+		0x90, 0x01, 0x73, 0xE2, 0x97, 0x06, 0x74, 0xE5,
+		0x9E, 0x0F, 0x7D, 0xEC, 0x99, 0x08, 0x7A, 0xEB,
+		0x8C, 0x1D, 0x6F, 0xFE, 0x8B, 0x1A, 0x68, 0xF9,
+		0x82, 0x13, 0x61, 0xF0, 0x85, 0x14, 0x66, 0xF7,
+		0xA8, 0x39, 0x4B, 0xDA, 0xAF, 0x3E, 0x4C, 0xDD,
+		0xA6, 0x37, 0x45, 0xD4, 0xA1, 0x30, 0x42, 0xD3,
+		0xB4, 0x25, 0x57, 0xC6, 0xB3, 0x22, 0x50, 0xC1,
+		0xBA, 0x2B, 0x59, 0xC8, 0xBD, 0x2C, 0x5E, 0xCF,
+	},
+	{8, 0x1D, true}: {
+		0x00, 0x64, 0xC8, 0xAC, 0xE1, 0x85, 0x29, 0x4D,
+		0xB3, 0xD7, 0x7B, 0x1F, 0x52, 0x36, 0x9A, 0xFE,
+		0x17, 0x73, 0xDF, 0xBB, 0xF6, 0x92, 0x3E, 0x5A,
+		0xA4, 0xC0, 0x6C, 0x08, 0x45, 0x21, 0x8D, 0xE9,
+		0x2E, 0x4A, 0xE6, 0x82, 0xCF, 0xAB, 0x07, 0x63,
+		0x9D, 0xF9, 0x55, 0x31, 0x7C, 0x18, 0xB4, 0xD0,
+		0x39, 0x5D, 0xF1, 0x95, 0xD8, 0xBC, 0x10, 0x74,
+		0x8A, 0xEE, 0x42, 0x26, 0x6B, 0x0F, 0xA3, 0xC7,
+		0x5C, 0x38, 0x94, 0xF0, 0xBD, 0xD9, 0x75, 0x11,
+		0xEF, 0x8B, 0x27, 0x43, 0x0E, 0x6A, 0xC6, 0xA2,
+		0x4B, 0x2F, 0x83, 0xE7, 0xAA, 0xCE, 0x62, 0x06,
+		0xF8, 0x9C, 0x30, 0x54, 0x19, 0x7D, 0xD1, 0xB5,
+		0x72, 0x16, 0xBA, 0xDE, 0x93, 0xF7, 0x5B, 0x3F,
+		0xC1, 0xA5, 0x09, 0x6D, 0x20, 0x44, 0xE8, 0x8C,
+		0x65, 0x01, 0xAD, 0xC9, 0x84, 0xE0, 0x4C, 0x28,
+		0xD6, 0xB2, 0x1E, 0x7A, 0x37, 0x53, 0xFF, 0x9B,
+		0xB8, 0xDC, 0x70, 0x14, 0x59, 0x3D, 0x91, 0xF5,
+		0x0B, 0x6F, 0xC3, 0xA7, 0xEA, 0x8E, 0x22, 0x46,
+		0xAF, 0xCB, 0x67, 0x03, 0x4E, 0x2A, 0x86, 0xE2,
+		0x1C, 0x78, 0xD4, 0xB0, 0xFD, 0x99, 0x35, 0x51,
+		0x96, 0xF2, 0x5E, 0x3A, 0x77, 0x13, 0xBF, 0xDB,
+		0x25, 0x41, 0xED, 0x89, 0xC4, 0xA0, 0x0C, 0x68,
+		0x81, 0xE5, 0x49, 0x2D, 0x60, 0x04, 0xA8, 0xCC,
+		0x32, 0x56, 0xFA, 0x9E, 0xD3, 0xB7, 0x1B, 0x7F,
+		0xE4, 0x80, 0x2C, 0x48, 0x05, 0x61, 0xCD, 0xA9,
+		0x57, 0x33, 0x9F, 0xFB, 0xB6, 0xD2, 0x7E, 0x1A,
+		0xF3, 0x97, 0x3B, 0x5F, 0x12, 0x76, 0xDA, 0xBE,
+		0x40, 0x24, 0x88, 0xEC, 0xA1, 0xC5, 0x69, 0x0D,
+		0xCA, 0xAE, 0x02, 0x66, 0x2B, 0x4F, 0xE3, 0x87,
+		0x79, 0x1D, 0xB1, 0xD5, 0x98, 0xFC, 0x50, 0x34,
+		0xDD, 0xB9, 0x15, 0x71, 0x3C, 0x58, 0xF4, 0x90,
+		0x6E, 0x0A, 0xA6, 0xC2, 0x8F, 0xEB, 0x47, 0x23,
+	},
+	{8, 0x9B, true}: {
+		0x00, 0xD0, 0x13, 0xC3, 0x26, 0xF6, 0x35, 0xE5,
+		0x4C, 0x9C, 0x5F, 0x8F, 0x6A, 0xBA, 0x79, 0xA9,
+		0x98, 0x48, 0x8B, 0x5B, 0xBE, 0x6E, 0xAD, 0x7D,
+		0xD4, 0x04, 0xC7, 0x17, 0xF2, 0x22, 0xE1, 0x31,
+		0x83, 0x53, 0x90, 0x40, 0xA5, 0x75, 0xB6, 0x66,
+		0xCF, 0x1F, 0xDC, 0x0C, 0xE9, 0x39, 0xFA, 0x2A,
+		0x1B, 0xCB, 0x08, 0xD8, 0x3D, 0xED, 0x2E, 0xFE,
+		0x57, 0x87, 0x44, 0x94, 0x71, 0xA1, 0x62, 0xB2,
+		0xB5, 0x65, 0xA6, 0x76, 0x93, 0x43, 0x80, 0x50,
+		0xF9, 0x29, 0xEA, 0x3A, 0xDF, 0x0F, 0xCC, 0x1C,
+		0x2D, 0xFD, 0x3E, 0xEE, 0x0B, 0xDB, 0x18, 0xC8,
+		0x61, 0xB1, 0x72, 0xA2, 0x47, 0x97, 0x54, 0x84,
+		0x36, 0xE6, 0x25, 0xF5, 0x10, 0xC0, 0x03, 0xD3,
+		0x7A, 0xAA, 0x69, 0xB9, 0x5C, 0x8C, 0x4F, 0x9F,
+		0xAE, 0x7E, 0xBD, 0x6D, 0x88, 0x58, 0x9B, 0x4B,
+		0xE2, 0x32, 0xF1, 0x21, 0xC4, 0x14, 0xD7, 0x07,
+		0xD9, 0x09, 0xCA, 0x1A, 0xFF, 0x2F, 0xEC, 0x3C,
+		0x95, 0x45, 0x86, 0x56, 0xB3, 0x63, 0xA0, 0x70,
+		0x41, 0x91, 0x52, 0x82, 0x67, 0xB7, 0x74, 0xA4,
+		0x0D, 0xDD, 0x1E, 0xCE, 0x2B, 0xFB, 0x38, 0xE8,
+		0x5A, 0x8A, 0x49, 0x99, 0x7C, 0xAC, 0x6F, 0xBF,
+		0x16, 0xC6, 0x05, 0xD5, 0x30, 0xE0, 0x23, 0xF3,
+		0xC2, 0x12, 0xD1, 0x01, 0xE4, 0x34, 0xF7, 0x27,
+		0x8E, 0x5E, 0x9D, 0x4D, 0xA8, 0x78, 0xBB, 0x6B,
+		0x6C, 0xBC, 0x7F, 0xAF, 0x4A, 0x9A, 0x59, 0x89,
+		0x20, 0xF0, 0x33, 0xE3, 0x06, 0xD6, 0x15, 0xC5,
+		0xF4, 0x24, 0xE7, 0x37, 0xD2, 0x02, 0xC1, 0x11,
+		0xB8, 0x68, 0xAB, 0x7B, 0x9E, 0x4E, 0x8D, 0x5D,
+		0xEF, 0x3F, 0xFC, 0x2C, 0xC9, 0x19, 0xDA, 0x0A,
+		0xA3, 0x73, 0xB0, 0x60, 0x85, 0x55, 0x96, 0x46,
+		0x77, 0xA7, 0x64, 0xB4, 0x51, 0x81, 0x42, 0x92,
+		0x3B, 0xEB, 0x28, 0xF8, 0x1D, 0xCD, 0x0E, 0xDE,
+	},
+	{10, 0x233, false}: {
+		0x000, 0x233, 0x255, 0x066, 0x299, 0x0AA, 0x0CC, 0x2FF,
+		0x301, 0x132, 0x154, 0x367, 0x198, 0x3AB, 0x3CD, 0x1FE,
+		0x031, 0x202, 0x264, 0x057, 0x2A8, 0x09B, 0x0FD, 0x2CE,
+		0x330, 0x103, 0x165, 0x356, 0x1A9, 0x39A, 0x3FC, 0x1CF,
+		0x062, 0x251, 0x237, 0x004, 0x2FB, 0x0C8, 0x0AE, 0x29D,
+		0x363, 0x150, 0x136, 0x305, 0x1FA, 0x3C9, 0x3AF, 0x19C,
+		0x053, 0x260, 0x206, 0x035, 0x2CA, 0x0F9, 0x09F, 0x2AC,
+		0x352, 0x161, 0x107, 0x334, 0x1CB, 0x3F8, 0x39E, 0x1AD,
+		0x0C4, 0x2F7, 0x291, 0x0A2, 0x25D, 0x06E, 0x008, 0x23B,
+		0x3C5, 0x1F6, 0x190, 0x3A3, 0x15C, 0x36F, 0x309, 0x13A,
+		0x0F5, 0x2C6, 0x2A0, 0x093, 0x26C, 0x05F, 0x039, 0x20A,
+		0x3F4, 0x1C7, 0x1A1, 0x392, 0x16D, 0x35E, 0x338, 0x10B,
+		0x0A6, 0x295, 0x2F3, 0x0C0, 0x23F, 0x00C, 0x06A, 0x259,
+		0x3A7, 0x194, 0x1F2, 0x3C1, 0x13E, 0x30D, 0x36B, 0x158,
+		0x097, 0x2A4, 0x2C2, 0x0F1, 0x20E, 0x03D, 0x05B, 0x268,
+		0x396, 0x1A5, 0x1C3, 0x3F0, 0x10F, 0x33C, 0x35A, 0x169,
+		0x188, 0x3BB, 0x3DD, 0x1EE, 0x311, 0x122, 0x144, 0x377,
+		0x289, 0x0BA, 0x0DC, 0x2EF, 0x010, 0x223, 0x245, 0x076,
+		0x1B9, 0x38A, 0x3EC, 0x1DF, 0x320, 0x113, 0x175, 0x346,
+		0x2B8, 0x08B, 0x0ED, 0x2DE, 0x021, 0x212, 0x274, 0x047,
+		0x1EA, 0x3D9, 0x3BF, 0x18C, 0x373, 0x140, 0x126, 0x315,
+		0x2EB, 0x0D8, 0x0BE, 0x28D, 0x072, 0x241, 0x227, 0x014,
+		0x1DB, 0x3E8, 0x38E, 0x1BD, 0x342, 0x171, 0x117, 0x324,
+		0x2DA, 0x0E9, 0x08F, 0x2BC, 0x043, 0x270, 0x216, 0x025,
+		0x14C, 0x37F, 0x319, 0x12A, 0x3D5, 0x1E6, 0x180, 0x3B3,
+		0x24D, 0x07E, 0x018, 0x22B, 0x0D4, 0x2E7, 0x281, 0x0B2,
+		0x17D, 0x34E, 0x328, 0x11B, 0x3E4, 0x1D7, 0x1B1, 0x382,
+		0x27C, 0x04F, 0x029, 0x21A, 0x0E5, 0x2D6, 0x2B0, 0x083,
+		0x12E, 0x31D, 0x37B, 0x148, 0x3B7, 0x184, 0x1E2, 0x3D1,
+		0x22F, 0x01C, 0x07A, 0x249, 0x0B6, 0x285, 0x2E3, 0x0D0,
+		0x11F, 0x32C, 0x34A, 0x179, 0x386, 0x1B5, 0x1D3, 0x3E0,
+		0x21E, 0x02D, 0x04B, 0x278, 0x087, 0x2B4, 0x2D2, 0x0E1,
+	},
+	{10, 0x3D9, false}: {
+		0x000, 0x3D9, 0x06B, 0x3B2, 0x0D6, 0x30F, 0x0BD, 0x364,
+		0x1AC, 0x275, 0x1C7, 0x21E, 0x17A, 0x2A3, 0x111, 0x2C8,
+		0x358, 0x081, 0x333, 0x0EA, 0x38E, 0x057, 0x3E5, 0x03C,
+		0x2F4, 0x12D, 0x29F, 0x146, 0x222, 0x1FB, 0x249, 0x190,
+		0x169, 0x2B0, 0x102, 0x2DB, 0x1BF, 0x266, 0x1D4, 0x20D,
+		0x0C5, 0x31C, 0x0AE, 0x377, 0x013, 0x3CA, 0x078, 0x3A1,
+		0x231, 0x1E8, 0x25A, 0x183, 0x2E7, 0x13E, 0x28C, 0x155,
+		0x39D, 0x044, 0x3F6, 0x02F, 0x34B, 0x092, 0x320, 0x0F9,
+		0x2D2, 0x10B, 0x2B9, 0x160, 0x204, 0x1DD, 0x26F, 0x1B6,
+		0x37E, 0x0A7, 0x315, 0x0CC, 0x3A8, 0x071, 0x3C3, 0x01A,
+		0x18A, 0x253, 0x1E1, 0x238, 0x15C, 0x285, 0x137, 0x2EE,
+		0x026, 0x3FF, 0x04D, 0x394, 0x0F0, 0x329, 0x09B, 0x342,
+		0x3BB, 0x062, 0x3D0, 0x009, 0x36D, 0x0B4, 0x306, 0x0DF,
+		0x217, 0x1CE, 0x27C, 0x1A5, 0x2C1, 0x118, 0x2AA, 0x173,
+		0x0E3, 0x33A, 0x088, 0x351, 0x035, 0x3EC, 0x05E, 0x387,
+		0x14F, 0x296, 0x124, 0x2FD, 0x199, 0x240, 0x1F2, 0x22B,
+		0x27D, 0x1A4, 0x216, 0x1CF, 0x2AB, 0x172, 0x2C0, 0x119,
+		0x3D1, 0x008, 0x3BA, 0x063, 0x307, 0x0DE, 0x36C, 0x0B5,
+		0x125, 0x2FC, 0x14E, 0x297, 0x1F3, 0x22A, 0x198, 0x241,
+		0x089, 0x350, 0x0E2, 0x33B, 0x05F, 0x386, 0x034, 0x3ED,
+		0x314, 0x0CD, 0x37F, 0x0A6, 0x3C2, 0x01B, 0x3A9, 0x070,
+		0x2B8, 0x161, 0x2D3, 0x10A, 0x26E, 0x1B7, 0x205, 0x1DC,
+		0x04C, 0x395, 0x027, 0x3FE, 0x09A, 0x343, 0x0F1, 0x328,
+		0x1E0, 0x239, 0x18B, 0x252, 0x136, 0x2EF, 0x15D, 0x284,
+		0x0AF, 0x376, 0x0C4, 0x31D, 0x079, 0x3A0, 0x012, 0x3CB,
+		0x103, 0x2DA, 0x168, 0x2B1, 0x1D5, 0x20C, 0x1BE, 0x267,
+		0x3F7, 0x02E, 0x39C, 0x045, 0x321, 0x0F8, 0x34A, 0x093,
+		0x25B, 0x182, 0x230, 0x1E9, 0x28D, 0x154, 0x2E6, 0x13F,
+		0x1C6, 0x21F, 0x1AD, 0x274, 0x110, 0x2C9, 0x17B, 0x2A2,
+		0x06A, 0x3B3, 0x001, 0x3D8, 0x0BC, 0x365, 0x0D7, 0x30E,
+		0x29E, 0x147, 0x2F5, 0x12C, 0x248, 0x191, 0x223, 0x1FA,
+		0x332, 0x0EB, 0x359, 0x080, 0x3E4, 0x03D, 0x38F, 0x056,
+	},
+	{10, 0x175, false}: {
+		0x000, 0x175, 0x2EA, 0x39F, 0x0A1, 0x1D4, 0x24B, 0x33E,
+		0x142, 0x037, 0x3A8, 0x2DD, 0x1E3, 0x096, 0x309, 0x27C,
+		0x284, 0x3F1, 0x06E, 0x11B, 0x225, 0x350, 0x0CF, 0x1BA,
+		0x3C6, 0x2B3, 0x12C, 0x059, 0x367, 0x212, 0x18D, 0x0F8,
+		0x07D, 0x108, 0x297, 0x3E2, 0x0DC, 0x1A9, 0x236, 0x343,
+		0x13F, 0x04A, 0x3D5, 0x2A0, 0x19E, 0x0EB, 0x374, 0x201,
+		0x2F9, 0x38C, 0x013, 0x166, 0x258, 0x32D, 0x0B2, 0x1C7,
+		0x3BB, 0x2CE, 0x151, 0x024, 0x31A, 0x26F, 0x1F0, 0x085,
+		0x0FA, 0x18F, 0x210, 0x365, 0x05B, 0x12E, 0x2B1, 0x3C4,
+		0x1B8, 0x0CD, 0x352, 0x227, 0x119, 0x06C, 0x3F3, 0x286,
+		0x27E, 0x30B, 0x094, 0x1E1, 0x2DF, 0x3AA, 0x035, 0x140,
+		0x33C, 0x249, 0x1D6, 0x0A3, 0x39D, 0x2E8, 0x177, 0x002,
+		0x087, 0x1F2, 0x26D, 0x318, 0x026, 0x153, 0x2CC, 0x3B9,
+		0x1C5, 0x0B0, 0x32F, 0x25A, 0x164, 0x011, 0x38E, 0x2FB,
+		0x203, 0x376, 0x0E9, 0x19C, 0x2A2, 0x3D7, 0x048, 0x13D,
+		0x341, 0x234, 0x1AB, 0x0DE, 0x3E0, 0x295, 0x10A, 0x07F,
+		0x1F4, 0x081, 0x31E, 0x26B, 0x155, 0x020, 0x3BF, 0x2CA,
+		0x0B6, 0x1C3, 0x25C, 0x329, 0x017, 0x162, 0x2FD, 0x388,
+		0x370, 0x205, 0x19A, 0x0EF, 0x3D1, 0x2A4, 0x13B, 0x04E,
+		0x232, 0x347, 0x0D8, 0x1AD, 0x293, 0x3E6, 0x079, 0x10C,
+		0x189, 0x0FC, 0x363, 0x216, 0x128, 0x05D, 0x3C2, 0x2B7,
+		0x0CB, 0x1BE, 0x221, 0x354, 0x06A, 0x11F, 0x280, 0x3F5,
+		0x30D, 0x278, 0x1E7, 0x092, 0x3AC, 0x2D9, 0x146, 0x033,
+		0x24F, 0x33A, 0x0A5, 0x1D0, 0x2EE, 0x39B, 0x004, 0x171,
+		0x10E, 0x07B, 0x3E4, 0x291, 0x1AF, 0x0DA, 0x345, 0x230,
+		0x04C, 0x139, 0x2A6, 0x3D3, 0x0ED, 0x198, 0x207, 0x372,
+		0x38A, 0x2FF, 0x160, 0x015, 0x32B, 0x25E, 0x1C1, 0x0B4,
+		0x2C8, 0x3BD, 0x022, 0x157, 0x269, 0x31C, 0x083, 0x1F6,
+		0x173, 0x006, 0x399, 0x2EC, 0x1D2, 0x0A7, 0x338, 0x24D,
+		0x031, 0x144, 0x2DB, 0x3AE, 0x090, 0x1E5, 0x27A, 0x30F,
+		0x3F7, 0x282, 0x11D, 0x068, 0x356, 0x223, 0x1BC, 0x0C9,
+		0x2B5, 0x3C0, 0x05F, 0x12A, 0x214, 0x361, 0x0FE, 0x18B,
+	},
+	{11, 0x385, false}: {
+		0x000, 0x385, 0x70A, 0x48F, 0x591, 0x614, 0x29B, 0x11E,
+		0x0A7, 0x322, 0x7AD, 0x428, 0x536, 0x6B3, 0x23C, 0x1B9,
+		0x14E, 0x2CB, 0x644, 0x5C1, 0x4DF, 0x75A, 0x3D5, 0x050,
+		0x1E9, 0x26C, 0x6E3, 0x566, 0x478, 0x7FD, 0x372, 0x0F7,
+		0x29C, 0x119, 0x596, 0x613, 0x70D, 0x488, 0x007, 0x382,
+		0x23B, 0x1BE, 0x531, 0x6B4, 0x7AA, 0x42F, 0x0A0, 0x325,
+		0x3D2, 0x057, 0x4D8, 0x75D, 0x643, 0x5C6, 0x149, 0x2CC,
+		0x375, 0x0F0, 0x47F, 0x7FA, 0x6E4, 0x561, 0x1EE, 0x26B,
+		0x538, 0x6BD, 0x232, 0x1B7, 0x0A9, 0x32C, 0x7A3, 0x426,
+		0x59F, 0x61A, 0x295, 0x110, 0x00E, 0x38B, 0x704, 0x481,
+		0x476, 0x7F3, 0x37C, 0x0F9, 0x1E7, 0x262, 0x6ED, 0x568,
+		0x4D1, 0x754, 0x3DB, 0x05E, 0x140, 0x2C5, 0x64A, 0x5CF,
+		0x7A4, 0x421, 0x0AE, 0x32B, 0x235, 0x1B0, 0x53F, 0x6BA,
+		0x703, 0x486, 0x009, 0x38C, 0x292, 0x117, 0x598, 0x61D,
+		0x6EA, 0x56F, 0x1E0, 0x265, 0x37B, 0x0FE, 0x471, 0x7F4,
+		0x64D, 0x5C8, 0x147, 0x2C2, 0x3DC, 0x059, 0x4D6, 0x753,
+		0x1F5, 0x270, 0x6FF, 0x57A, 0x464, 0x7E1, 0x36E, 0x0EB,
+		0x152, 0x2D7, 0x658, 0x5DD, 0x4C3, 0x746, 0x3C9, 0x04C,
+		0x0BB, 0x33E, 0x7B1, 0x434, 0x52A, 0x6AF, 0x220, 0x1A5,
+		0x01C, 0x399, 0x716, 0x493, 0x58D, 0x608, 0x287, 0x102,
+		0x369, 0x0EC, 0x463, 0x7E6, 0x6F8, 0x57D, 0x1F2, 0x277,
+		0x3CE, 0x04B, 0x4C4, 0x741, 0x65F, 0x5DA, 0x155, 0x2D0,
+		0x227, 0x1A2, 0x52D, 0x6A8, 0x7B6, 0x433, 0x0BC, 0x339,
+		0x280, 0x105, 0x58A, 0x60F, 0x711, 0x494, 0x01B, 0x39E,
+		0x4CD, 0x748, 0x3C7, 0x042, 0x15C, 0x2D9, 0x656, 0x5D3,
+		0x46A, 0x7EF, 0x360, 0x0E5, 0x1FB, 0x27E, 0x6F1, 0x574,
+		0x583, 0x606, 0x289, 0x10C, 0x012, 0x397, 0x718, 0x49D,
+		0x524, 0x6A1, 0x22E, 0x1AB, 0x0B5, 0x330, 0x7BF, 0x43A,
+		0x651, 0x5D4, 0x15B, 0x2DE, 0x3C0, 0x045, 0x4CA, 0x74F,
+		0x6F6, 0x573, 0x1FC, 0x279, 0x367, 0x0E2, 0x46D, 0x7E8,
+		0x71F, 0x49A, 0x015, 0x390, 0x28E, 0x10B, 0x584, 0x601,
+		0x7B8, 0x43D, 0x0B2, 0x337, 0x229, 0x1AC, 0x523, 0x6A6,
+	},
+	{11, 0x307, false}: {
+		0x000, 0x307, 0x60E, 0x509, 0x71B, 0x41C, 0x115, 0x212,
+		0x531, 0x636, 0x33F, 0x038, 0x22A, 0x12D, 0x424, 0x723,
+		0x165, 0x262, 0x76B, 0x46C, 0x67E, 0x579, 0x070, 0x377,
+		0x454, 0x753, 0x25A, 0x15D, 0x34F, 0x048, 0x541, 0x646,
+		0x2CA, 0x1CD, 0x4C4, 0x7C3, 0x5D1, 0x6D6, 0x3DF, 0x0D8,
+		0x7FB, 0x4FC, 0x1F5, 0x2F2, 0x0E0, 0x3E7, 0x6EE, 0x5E9,
+		0x3AF, 0x0A8, 0x5A1, 0x6A6, 0x4B4, 0x7B3, 0x2BA, 0x1BD,
+		0x69E, 0x599, 0x090, 0x397, 0x185, 0x282, 0x78B, 0x48C,
+		0x594, 0x693, 0x39A, 0x09D, 0x28F, 0x188, 0x481, 0x786,
+		0x0A5, 0x3A2, 0x6AB, 0x5AC, 0x7BE, 0x4B9, 0x1B0, 0x2B7,
+		0x4F1, 0x7F6, 0x2FF, 0x1F8, 0x3EA, 0x0ED, 0x5E4, 0x6E3,
+		0x1C0, 0x2C7, 0x7CE, 0x4C9, 0x6DB, 0x5DC, 0x0D5, 0x3D2,
+		0x75E, 0x459, 0x150, 0x257, 0x045, 0x342, 0x64B, 0x54C,
+		0x26F, 0x168, 0x461, 0x766, 0x574, 0x673, 0x37A, 0x07D,
+		0x63B, 0x53C, 0x035, 0x332, 0x120, 0x227, 0x72E, 0x429,
+		0x30A, 0x00D, 0x504, 0x603, 0x411, 0x716, 0x21F, 0x118,
+		0x02F, 0x328, 0x621, 0x526, 0x734, 0x433, 0x13A, 0x23D,
+		0x51E, 0x619, 0x310, 0x017, 0x205, 0x102, 0x40B, 0x70C,
+		0x14A, 0x24D, 0x744, 0x443, 0x651, 0x556, 0x05F, 0x358,
+		0x47B, 0x77C, 0x275, 0x172, 0x360, 0x067, 0x56E, 0x669,
+		0x2E5, 0x1E2, 0x4EB, 0x7EC, 0x5FE, 0x6F9, 0x3F0, 0x0F7,
+		0x7D4, 0x4D3, 0x1DA, 0x2DD, 0x0CF, 0x3C8, 0x6C1, 0x5C6,
+		0x380, 0x087, 0x58E, 0x689, 0x49B, 0x79C, 0x295, 0x192,
+		0x6B1, 0x5B6, 0x0BF, 0x3B8, 0x1AA, 0x2AD, 0x7A4, 0x4A3,
+		0x5BB, 0x6BC, 0x3B5, 0x0B2, 0x2A0, 0x1A7, 0x4AE, 0x7A9,
+		0x08A, 0x38D, 0x684, 0x583, 0x791, 0x496, 0x19F, 0x298,
+		0x4DE, 0x7D9, 0x2D0, 0x1D7, 0x3C5, 0x0C2, 0x5CB, 0x6CC,
+		0x1EF, 0x2E8, 0x7E1, 0x4E6, 0x6F4, 0x5F3, 0x0FA, 0x3FD,
+		0x771, 0x476, 0x17F, 0x278, 0x06A, 0x36D, 0x664, 0x563,
+		0x240, 0x147, 0x44E, 0x749, 0x55B, 0x65C, 0x355, 0x052,
+		0x614, 0x513, 0x01A, 0x31D, 0x10F, 0x208, 0x701, 0x406,
+		0x325, 0x022, 0x52B, 0x62C, 0x43E, 0x739, 0x230, 0x137,
+	},
+	{12, 0xF13, false}: {
+		0x000, 0xF13, 0x135, 0xE26, 0x26A, 0xD79, 0x35F, 0xC4C,
+		0x4D4, 0xBC7, 0x5E1, 0xAF2, 0x6BE, 0x9AD, 0x78B, 0x898,
+		0x9A8, 0x6BB, 0x89D, 0x78E, 0xBC2, 0x4D1, 0xAF7, 0x5E4,
+		0xD7C, 0x26F, 0xC49, 0x35A, 0xF16, 0x005, 0xE23, 0x130,
+		0xC43, 0x350, 0xD76, 0x265, 0xE29, 0x13A, 0xF1C, 0x00F,
+		0x897, 0x784, 0x9A2, 0x6B1, 0xAFD, 0x5EE, 0xBC8, 0x4DB,
+		0x5EB, 0xAF8, 0x4DE, 0xBCD, 0x781, 0x892, 0x6B4, 0x9A7,
+		0x13F, 0xE2C, 0x00A, 0xF19, 0x355, 0xC46, 0x260, 0xD73,
+		0x795, 0x886, 0x6A0, 0x9B3, 0x5FF, 0xAEC, 0x4CA, 0xBD9,
+		0x341, 0xC52, 0x274, 0xD67, 0x12B, 0xE38, 0x01E, 0xF0D,
+		0xE3D, 0x12E, 0xF08, 0x01B, 0xC57, 0x344, 0xD62, 0x271,
+		0xAE9, 0x5FA, 0xBDC, 0x4CF, 0x883, 0x790, 0x9B6, 0x6A5,
+		0xBD6, 0x4C5, 0xAE3, 0x5F0, 0x9BC, 0x6AF, 0x889, 0x79A,
+		0xF02, 0x011, 0xE37, 0x124, 0xD68, 0x27B, 0xC5D, 0x34E,
+		0x27E, 0xD6D, 0x34B, 0xC58, 0x014, 0xF07, 0x121, 0xE32,
+		0x6AA, 0x9B9, 0x79F, 0x88C, 0x4C0, 0xBD3, 0x5F5, 0xAE6,
+		0xF2A, 0x039, 0xE1F, 0x10C, 0xD40, 0x253, 0xC75, 0x366,
+		0xBFE, 0x4ED, 0xACB, 0x5D8, 0x994, 0x687, 0x8A1, 0x7B2,
+		0x682, 0x991, 0x7B7, 0x8A4, 0x4E8, 0xBFB, 0x5DD, 0xACE,
+		0x256, 0xD45, 0x363, 0xC70, 0x03C, 0xF2F, 0x109, 0xE1A,
+		0x369, 0xC7A, 0x25C, 0xD4F, 0x103, 0xE10, 0x036, 0xF25,
+		0x7BD, 0x8AE, 0x688, 0x99B, 0x5D7, 0xAC4, 0x4E2, 0xBF1,
+		0xAC1, 0x5D2, 0xBF4, 0x4E7, 0x8AB, 0x7B8, 0x99E, 0x68D,
+		0xE15, 0x106, 0xF20, 0x033, 0xC7F, 0x36C, 0xD4A, 0x259,
+		0x8BF, 0x7AC, 0x98A, 0x699, 0xAD5, 0x5C6, 0xBE0, 0x4F3,
+		0xC6B, 0x378, 0xD5E, 0x24D, 0xE01, 0x112, 0xF34, 0x027,
+		0x117, 0xE04, 0x022, 0xF31, 0x37D, 0xC6E, 0x248, 0xD5B,
+		0x5C3, 0xAD0, 0x4F6, 0xBE5, 0x7A9, 0x8BA, 0x69C, 0x98F,
+		0x4FC, 0xBEF, 0x5C9, 0xADA, 0x696, 0x985, 0x7A3, 0x8B0,
+		0x028, 0xF3B, 0x11D, 0xE0E, 0x242, 0xD51, 0x377, 0xC64,
+		0xD54, 0x247, 0xC61, 0x372, 0xF3E, 0x02D, 0xE0B, 0x118,
+		0x980, 0x693, 0x8B5, 0x7A6, 0xBEA, 0x4F9, 0xADF, 0x5CC,
+	},
+	{12, 0x80F, false}: {
+		0x000, 0x80F, 0x811, 0x01E, 0x82D, 0x022, 0x03C, 0x833,
+		0x855, 0x05A, 0x044, 0x84B, 0x078, 0x877, 0x869, 0x066,
+		0x8A5, 0x0AA, 0x0B4, 0x8BB, 0x088, 0x887, 0x899, 0x096,
+		0x0F0, 0x8FF, 0x8E1, 0x0EE, 0x8DD, 0x0D2, 0x0CC, 0x8C3,
+		0x945, 0x14A, 0x154, 0x95B, 0x168, 0x967, 0x979, 0x176,
+		0x110, 0x91F, 0x901, 0x10E, 0x93D, 0x132, 0x12C, 0x923,
+		0x1E0, 0x9EF, 0x9F1, 0x1FE, 0x9CD, 0x1C2, 0x1DC, 0x9D3,
+		0x9B5, 0x1BA, 0x1A4, 0x9AB, 0x198, 0x997, 0x989, 0x186,
+		0xA85, 0x28A, 0x294, 0xA9B, 0x2A8, 0xAA7, 0xAB9, 0x2B6,
+		0x2D0, 0xADF, 0xAC1, 0x2CE, 0xAFD, 0x2F2, 0x2EC, 0xAE3,
+		0x220, 0xA2F, 0xA31, 0x23E, 0xA0D, 0x202, 0x21C, 0xA13,
+		0xA75, 0x27A, 0x264, 0xA6B, 0x258, 0xA57, 0xA49, 0x246,
+		0x3C0, 0xBCF, 0xBD1, 0x3DE, 0xBED, 0x3E2, 0x3FC, 0xBF3,
+		0xB95, 0x39A, 0x384, 0xB8B, 0x3B8, 0xBB7, 0xBA9, 0x3A6,
+		0xB65, 0x36A, 0x374, 0xB7B, 0x348, 0xB47, 0xB59, 0x356,
+		0x330, 0xB3F, 0xB21, 0x32E, 0xB1D, 0x312, 0x30C, 0xB03,
+		0xD05, 0x50A, 0x514, 0xD1B, 0x528, 0xD27, 0xD39, 0x536,
+		0x550, 0xD5F, 0xD41, 0x54E, 0xD7D, 0x572, 0x56C, 0xD63,
+		0x5A0, 0xDAF, 0xDB1, 0x5BE, 0xD8D, 0x582, 0x59C, 0xD93,
+		0xDF5, 0x5FA, 0x5E4, 0xDEB, 0x5D8, 0xDD7, 0xDC9, 0x5C6,
+		0x440, 0xC4F, 0xC51, 0x45E, 0xC6D, 0x462, 0x47C, 0xC73,
+		0xC15, 0x41A, 0x404, 0xC0B, 0x438, 0xC37, 0xC29, 0x426,
+		0xCE5, 0x4EA, 0x4F4, 0xCFB, 0x4C8, 0xCC7, 0xCD9, 0x4D6,
+		0x4B0, 0xCBF, 0xCA1, 0x4AE, 0xC9D, 0x492, 0x48C, 0xC83,
+		0x780, 0xF8F, 0xF91, 0x79E, 0xFAD, 0x7A2, 0x7BC, 0xFB3,
+		0xFD5, 0x7DA, 0x7C4, 0xFCB, 0x7F8, 0xFF7, 0xFE9, 0x7E6,
+		0xF25, 0x72A, 0x734, 0xF3B, 0x708, 0xF07, 0xF19, 0x716,
+		0x770, 0xF7F, 0xF61, 0x76E, 0xF5D, 0x752, 0x74C, 0xF43,
+		0xEC5, 0x6CA, 0x6D4, 0xEDB, 0x6E8, 0xEE7, 0xEF9, 0x6F6,
+		0x690, 0xE9F, 0xE81, 0x68E, 0xEBD, 0x6B2, 0x6AC, 0xEA3,
+		0x660, 0xE6F, 0xE71, 0x67E, 0xE4D, 0x642, 0x65C, 0xE53,
+		0xE35, 0x63A, 0x624, 0xE2B, 0x618, 0xE17, 0xE09, 0x606,
+	},
+	{12, 0xD31, false}: {
+		0x000, 0xD31, 0x753, 0xA62, 0xEA6, 0x397, 0x9F5, 0x4C4,
+		0x07D, 0xD4C, 0x72E, 0xA1F, 0xEDB, 0x3EA, 0x988, 0x4B9,
+		0x0FA, 0xDCB, 0x7A9, 0xA98, 0xE5C, 0x36D, 0x90F, 0x43E,
+		0x087, 0xDB6, 0x7D4, 0xAE5, 0xE21, 0x310, 0x972, 0x443,
+		0x1F4, 0xCC5, 0x6A7, 0xB96, 0xF52, 0x263, 0x801, 0x530,
+		0x189, 0xCB8, 0x6DA, 0xBEB, 0xF2F, 0x21E, 0x87C, 0x54D,
+		0x10E, 0xC3F, 0x65D, 0xB6C, 0xFA8, 0x299, 0x8FB, 0x5CA,
+		0x173, 0xC42, 0x620, 0xB11, 0xFD5, 0x2E4, 0x886, 0x5B7,
+		0x3E8, 0xED9, 0x4BB, 0x98A, 0xD4E, 0x07F, 0xA1D, 0x72C,
+		0x395, 0xEA4, 0x4C6, 0x9F7, 0xD33, 0x002, 0xA60, 0x751,
+		0x312, 0xE23, 0x441, 0x970, 0xDB4, 0x085, 0xAE7, 0x7D6,
+		0x36F, 0xE5E, 0x43C, 0x90D, 0xDC9, 0x0F8, 0xA9A, 0x7AB,
+		0x21C, 0xF2D, 0x54F, 0x87E, 0xCBA, 0x18B, 0xBE9, 0x6D8,
+		0x261, 0xF50, 0x532, 0x803, 0xCC7, 0x1F6, 0xB94, 0x6A5,
+		0x2E6, 0xFD7, 0x5B5, 0x884, 0xC40, 0x171, 0xB13, 0x622,
+		0x29B, 0xFAA, 0x5C8, 0x8F9, 0xC3D, 0x10C, 0xB6E, 0x65F,
+		0x7D0, 0xAE1, 0x083, 0xDB2, 0x976, 0x447, 0xE25, 0x314,
+		0x7AD, 0xA9C, 0x0FE, 0xDCF, 0x90B, 0x43A, 0xE58, 0x369,
+		0x72A, 0xA1B, 0x079, 0xD48, 0x98C, 0x4BD, 0xEDF, 0x3EE,
+		0x757, 0xA66, 0x004, 0xD35, 0x9F1, 0x4C0, 0xEA2, 0x393,
+		0x624, 0xB15, 0x177, 0xC46, 0x882, 0x5B3, 0xFD1, 0x2E0,
+		0x659, 0xB68, 0x10A, 0xC3B, 0x8FF, 0x5CE, 0xFAC, 0x29D,
+		0x6DE, 0xBEF, 0x18D, 0xCBC, 0x878, 0x549, 0xF2B, 0x21A,
+		0x6A3, 0xB92, 0x1F0, 0xCC1, 0x805, 0x534, 0xF56, 0x267,
+		0x438, 0x909, 0x36B, 0xE5A, 0xA9E, 0x7AF, 0xDCD, 0x0FC,
+		0x445, 0x974, 0x316, 0xE27, 0xAE3, 0x7D2, 0xDB0, 0x081,
+		0x4C2, 0x9F3, 0x391, 0xEA0, 0xA64, 0x755, 0xD37, 0x006,
+		0x4BF, 0x98E, 0x3EC, 0xEDD, 0xA19, 0x728, 0xD4A, 0x07B,
+		0x5CC, 0x8FD, 0x29F, 0xFAE, 0xB6A, 0x65B, 0xC39, 0x108,
+		0x5B1, 0x880, 0x2E2, 0xFD3, 0xB17, 0x626, 0xC44, 0x175,
+		0x536, 0x807, 0x265, 0xF54, 0xB90, 0x6A1, 0xCC3, 0x1F2,
+		0x54B, 0x87A, 0x218, 0xF29, 0xBED, 0x6DC, 0xCBE, 0x18F,
+	},
+	{13, 0x1CF5, false}: {
+		0x0000, 0x1CF5, 0x051F, 0x19EA, 0x0A3E, 0x16CB, 0x0F21, 0x13D4,
+		0x147C, 0x0889, 0x1163, 0x0D96, 0x1E42, 0x02B7, 0x1B5D, 0x07A8,
+		0x140D, 0x08F8, 0x1112, 0x0DE7, 0x1E33, 0x02C6, 0x1B2C, 0x07D9,
+		0x0071, 0x1C84, 0x056E, 0x199B, 0x0A4F, 0x16BA, 0x0F50, 0x13A5,
+		0x14EF, 0x081A, 0x11F0, 0x0D05, 0x1ED1, 0x0224, 0x1BCE, 0x073B,
+		0x0093, 0x1C66, 0x058C, 0x1979, 0x0AAD, 0x1658, 0x0FB2, 0x1347,
+		0x00E2, 0x1C17, 0x05FD, 0x1908, 0x0ADC, 0x1629, 0x0FC3, 0x1336,
+		0x149E, 0x086B, 0x1181, 0x0D74, 0x1EA0, 0x0255, 0x1BBF, 0x074A,
+		0x152B, 0x09DE, 0x1034, 0x0CC1, 0x1F15, 0x03E0, 0x1A0A, 0x06FF,
+		0x0157, 0x1DA2, 0x0448, 0x18BD, 0x0B69, 0x179C, 0x0E76, 0x1283,
+		0x0126, 0x1DD3, 0x0439, 0x18CC, 0x0B18, 0x17ED, 0x0E07, 0x12F2,
+		0x155A, 0x09AF, 0x1045, 0x0CB0, 0x1F64, 0x0391, 0x1A7B, 0x068E,
+		0x01C4, 0x1D31, 0x04DB, 0x182E, 0x0BFA, 0x170F, 0x0EE5, 0x1210,
+		0x15B8, 0x094D, 0x10A7, 0x0C52, 0x1F86, 0x0373, 0x1A99, 0x066C,
+		0x15C9, 0x093C, 0x10D6, 0x0C23, 0x1FF7, 0x0302, 0x1AE8, 0x061D,
+		0x01B5, 0x1D40, 0x04AA, 0x185F, 0x0B8B, 0x177E, 0x0E94, 0x1261,
+		0x16A3, 0x0A56, 0x13BC, 0x0F49, 0x1C9D, 0x0068, 0x1982, 0x0577,
+		0x02DF, 0x1E2A, 0x07C0, 0x1B35, 0x08E1, 0x1414, 0x0DFE, 0x110B,
+		0x02AE, 0x1E5B, 0x07B1, 0x1B44, 0x0890, 0x1465, 0x0D8F, 0x117A,
+		0x16D2, 0x0A27, 0x13CD, 0x0F38, 0x1CEC, 0x0019, 0x19F3, 0x0506,
+		0x024C, 0x1EB9, 0x0753, 0x1BA6, 0x0872, 0x1487, 0x0D6D, 0x1198,
+		0x1630, 0x0AC5, 0x132F, 0x0FDA, 0x1C0E, 0x00FB, 0x1911, 0x05E4,
+		0x1641, 0x0AB4, 0x135E, 0x0FAB, 0x1C7F, 0x008A, 0x1960, 0x0595,
+		0x023D, 0x1EC8, 0x0722, 0x1BD7, 0x0803, 0x14F6, 0x0D1C, 0x11E9,
+		0x0388, 0x1F7D, 0x0697, 0x1A62, 0x09B6, 0x1543, 0x0CA9, 0x105C,
+		0x17F4, 0x0B01, 0x12EB, 0x0E1E, 0x1DCA, 0x013F, 0x18D5, 0x0420,
+		0x1785, 0x0B70, 0x129A, 0x0E6F, 0x1DBB, 0x014E, 0x18A4, 0x0451,
+		0x03F9, 0x1F0C, 0x06E6, 0x1A13, 0x09C7, 0x1532, 0x0CD8, 0x102D,
+		0x1767, 0x0B92, 0x1278, 0x0E8D, 0x1D59, 0x01AC, 0x1846, 0x04B3,
+		0x031B, 0x1FEE, 0x0604, 0x1AF1, 0x0925, 0x15D0, 0x0C3A, 0x10CF,
+		0x036A, 0x1F9F, 0x0675, 0x1A80, 0x0954, 0x15A1, 0x0C4B, 0x10BE,
+		0x1716, 0x0BE3, 0x1209, 0x0EFC, 0x1D28, 0x01DD, 0x1837, 0x04C2,
+	},
+	{14, 0x805, true}: {
+		0x0000, 0x16D2, 0x2DA4, 0x3B76, 0x0B41, 0x1D93, 0x26E5, 0x3037,
+		0x1682, 0x0050, 0x3B26, 0x2DF4, 0x1DC3, 0x0B11, 0x3067, 0x26B5,
+		0x2D04, 0x3BD6, 0x00A0, 0x1672, 0x2645, 0x3097, 0x0BE1, 0x1D33,
+		0x3B86, 0x2D54, 0x1622, 0x00F0, 0x30C7, 0x2615, 0x1D63, 0x0BB1,
+		0x0A01, 0x1CD3, 0x27A5, 0x3177, 0x0140, 0x1792, 0x2CE4, 0x3A36,
+		0x1C83, 0x0A51, 0x3127, 0x27F5, 0x17C2, 0x0110, 0x3A66, 0x2CB4,
+		0x2705, 0x31D7, 0x0AA1, 0x1C73, 0x2C44, 0x3A96, 0x01E0, 0x1732,
+		0x3187, 0x2755, 0x1C23, 0x0AF1, 0x3AC6, 0x2C14, 0x1762, 0x01B0,
+		0x1402, 0x02D0, 0x39A6, 0x2F74, 0x1F43, 0x0991, 0x32E7, 0x2435,
+		0x0280, 0x1452, 0x2F24, 0x39F6, 0x09C1, 0x1F13, 0x2465, 0x32B7,
+		0x3906, 0x2FD4, 0x14A2, 0x0270, 0x3247, 0x2495, 0x1FE3, 0x0931,
+		0x2F84, 0x3956, 0x0220, 0x14F2, 0x24C5, 0x3217, 0x0961, 0x1FB3,
+		0x1E03, 0x08D1, 0x33A7, 0x2575, 0x1542, 0x0390, 0x38E6, 0x2E34,
+		0x0881, 0x1E53, 0x2525, 0x33F7, 0x03C0, 0x1512, 0x2E64, 0x38B6,
+		0x3307, 0x25D5, 0x1EA3, 0x0871, 0x3846, 0x2E94, 0x15E2, 0x0330,
+		0x2585, 0x3357, 0x0821, 0x1EF3, 0x2EC4, 0x3816, 0x0360, 0x15B2,
+		0x2804, 0x3ED6, 0x05A0, 0x1372, 0x2345, 0x3597, 0x0EE1, 0x1833,
+		0x3E86, 0x2854, 0x1322, 0x05F0, 0x35C7, 0x2315, 0x1863, 0x0EB1,
+		0x0500, 0x13D2, 0x28A4, 0x3E76, 0x0E41, 0x1893, 0x23E5, 0x3537,
+		0x1382, 0x0550, 0x3E26, 0x28F4, 0x18C3, 0x0E11, 0x3567, 0x23B5,
+		0x2205, 0x34D7, 0x0FA1, 0x1973, 0x2944, 0x3F96, 0x04E0, 0x1232,
+		0x3487, 0x2255, 0x1923, 0x0FF1, 0x3FC6, 0x2914, 0x1262, 0x04B0,
+		0x0F01, 0x19D3, 0x22A5, 0x3477, 0x0440, 0x1292, 0x29E4, 0x3F36,
+		0x1983, 0x0F51, 0x3427, 0x22F5, 0x12C2, 0x0410, 0x3F66, 0x29B4,
+		0x3C06, 0x2AD4, 0x11A2, 0x0770, 0x3747, 0x2195, 0x1AE3, 0x0C31,
+		0x2A84, 0x3C56, 0x0720, 0x11F2, 0x21C5, 0x3717, 0x0C61, 0x1AB3,
+		0x1102, 0x07D0, 0x3CA6, 0x2A74, 0x1A43, 0x0C91, 0x37E7, 0x2135,
+		0x0780, 0x1152, 0x2A24, 0x3CF6, 0x0CC1, 0x1A13, 0x2165, 0x37B7,
+		0x3607, 0x20D5, 0x1BA3, 0x0D71, 0x3D46, 0x2B94, 0x10E2, 0x0630,
+		0x2085, 0x3657, 0x0D21, 0x1BF3, 0x2BC4, 0x3D16, 0x0660, 0x10B2,
+		0x1B03, 0x0DD1, 0x36A7, 0x2075, 0x1042, 0x0690, 0x3DE6, 0x2B34,
+		0x0D81, 0x1B53, 0x2025, 0x36F7, 0x06C0, 0x1012, 0x2B64, 0x3DB6,
+	},
+	{14, 0x202D, false}: {
+		0x0000, 0x202D, 0x2077, 0x005A, 0x20C3, 0x00EE, 0x00B4, 0x2099,
+		0x21AB, 0x0186, 0x01DC, 0x21F1, 0x0168, 0x2145, 0x211F, 0x0132,
+		0x237B, 0x0356, 0x030C, 0x2321, 0x03B8, 0x2395, 0x23CF, 0x03E2,
+		0x02D0, 0x22FD, 0x22A7, 0x028A, 0x2213, 0x023E, 0x0264, 0x2249,
+		0x26DB, 0x06F6, 0x06AC, 0x2681, 0x0618, 0x2635, 0x266F, 0x0642,
+		0x0770, 0x275D, 0x2707, 0x072A, 0x27B3, 0x079E, 0x07C4, 0x27E9,
+		0x05A0, 0x258D, 0x25D7, 0x05FA, 0x2563, 0x054E, 0x0514, 0x2539,
+		0x240B, 0x0426, 0x047C, 0x2451, 0x04C8, 0x24E5, 0x24BF, 0x0492,
+		0x2D9B, 0x0DB6, 0x0DEC, 0x2DC1, 0x0D58, 0x2D75, 0x2D2F, 0x0D02,
+		0x0C30, 0x2C1D, 0x2C47, 0x0C6A, 0x2CF3, 0x0CDE, 0x0C84, 0x2CA9,
+		0x0EE0, 0x2ECD, 0x2E97, 0x0EBA, 0x2E23, 0x0E0E, 0x0E54, 0x2E79,
+		0x2F4B, 0x0F66, 0x0F3C, 0x2F11, 0x0F88, 0x2FA5, 0x2FFF, 0x0FD2,
+		0x0B40, 0x2B6D, 0x2B37, 0x0B1A, 0x2B83, 0x0BAE, 0x0BF4, 0x2BD9,
+		0x2AEB, 0x0AC6, 0x0A9C, 0x2AB1, 0x0A28, 0x2A05, 0x2A5F, 0x0A72,
+		0x283B, 0x0816, 0x084C, 0x2861, 0x08F8, 0x28D5, 0x288F, 0x08A2,
+		0x0990, 0x29BD, 0x29E7, 0x09CA, 0x2953, 0x097E, 0x0924, 0x2909,
+		0x3B1B, 0x1B36, 0x1B6C, 0x3B41, 0x1BD8, 0x3BF5, 0x3BAF, 0x1B82,
+		0x1AB0, 0x3A9D, 0x3AC7, 0x1AEA, 0x3A73, 0x1A5E, 0x1A04, 0x3A29,
+		0x1860, 0x384D, 0x3817, 0x183A, 0x38A3, 0x188E, 0x18D4, 0x38F9,
+		0x39CB, 0x19E6, 0x19BC, 0x3991, 0x1908, 0x3925, 0x397F, 0x1952,
+		0x1DC0, 0x3DED, 0x3DB7, 0x1D9A, 0x3D03, 0x1D2E, 0x1D74, 0x3D59,
+		0x3C6B, 0x1C46, 0x1C1C, 0x3C31, 0x1CA8, 0x3C85, 0x3CDF, 0x1CF2,
+		0x3EBB, 0x1E96, 0x1ECC, 0x3EE1, 0x1E78, 0x3E55, 0x3E0F, 0x1E22,
+		0x1F10, 0x3F3D, 0x3F67, 0x1F4A, 0x3FD3, 0x1FFE, 0x1FA4, 0x3F89,
+		0x1680, 0x36AD, 0x36F7, 0x16DA, 0x3643, 0x166E, 0x1634, 0x3619,
+		0x372B, 0x1706, 0x175C, 0x3771, 0x17E8, 0x37C5, 0x379F, 0x17B2,
+		0x35FB, 0x15D6, 0x158C, 0x35A1, 0x1538, 0x3515, 0x354F, 0x1562,
+		0x1450, 0x347D, 0x3427, 0x140A, 0x3493, 0x14BE, 0x14E4, 0x34C9,
+		0x305B, 0x1076, 0x102C, 0x3001, 0x1098, 0x30B5, 0x30EF, 0x10C2,
+		0x11F0, 0x31DD, 0x3187, 0x11AA, 0x3133, 0x111E, 0x1144, 0x3169,
+		0x1320, 0x330D, 0x3357, 0x137A, 0x33E3, 0x13CE, 0x1394, 0x33B9,
+		0x328B, 0x12A6, 0x12FC, 0x32D1, 0x1248, 0x3265, 0x323F, 0x1212,
+	},
+	{15, 0x4599, false}: {
+		0x0000, 0x4599, 0x4EAB, 0x0B32, 0x58CF, 0x1D56, 0x1664, 0x53FD,
+		0x7407, 0x319E, 0x3AAC, 0x7F35, 0x2CC8, 0x6951, 0x6263, 0x27FA,
+		0x2D97, 0x680E, 0x633C, 0x26A5, 0x7558, 0x30C1, 0x3BF3, 0x7E6A,
+		0x5990, 0x1C09, 0x173B, 0x52A2, 0x015F, 0x44C6, 0x4FF4, 0x0A6D,
+		0x5B2E, 0x1EB7, 0x1585, 0x501C, 0x03E1, 0x4678, 0x4D4A, 0x08D3,
+		0x2F29, 0x6AB0, 0x6182, 0x241B, 0x77E6, 0x327F, 0x394D, 0x7CD4,
+		0x76B9, 0x3320, 0x3812, 0x7D8B, 0x2E76, 0x6BEF, 0x60DD, 0x2544,
+		0x02BE, 0x4727, 0x4C15, 0x098C, 0x5A71, 0x1FE8, 0x14DA, 0x5143,
+		0x73C5, 0x365C, 0x3D6E, 0x78F7, 0x2B0A, 0x6E93, 0x65A1, 0x2038,
+		0x07C2, 0x425B, 0x4969, 0x0CF0, 0x5F0D, 0x1A94, 0x11A6, 0x543F,
+		0x5E52, 0x1BCB, 0x10F9, 0x5560, 0x069D, 0x4304, 0x4836, 0x0DAF,
+		0x2A55, 0x6FCC, 0x64FE, 0x2167, 0x729A, 0x3703, 0x3C31, 0x79A8,
+		0x28EB, 0x6D72, 0x6640, 0x23D9, 0x7024, 0x35BD, 0x3E8F, 0x7B16,
+		0x5CEC, 0x1975, 0x1247, 0x57DE, 0x0423, 0x41BA, 0x4A88, 0x0F11,
+		0x057C, 0x40E5, 0x4BD7, 0x0E4E, 0x5DB3, 0x182A, 0x1318, 0x5681,
+		0x717B, 0x34E2, 0x3FD0, 0x7A49, 0x29B4, 0x6C2D, 0x671F, 0x2286,
+		0x2213, 0x678A, 0x6CB8, 0x2921, 0x7ADC, 0x3F45, 0x3477, 0x71EE,
+		0x5614, 0x138D, 0x18BF, 0x5D26, 0x0EDB, 0x4B42, 0x4070, 0x05E9,
+		0x0F84, 0x4A1D, 0x412F, 0x04B6, 0x574B, 0x12D2, 0x19E0, 0x5C79,
+		0x7B83, 0x3E1A, 0x3528, 0x70B1, 0x234C, 0x66D5, 0x6DE7, 0x287E,
+		0x793D, 0x3CA4, 0x3796, 0x720F, 0x21F2, 0x646B, 0x6F59, 0x2AC0,
+		0x0D3A, 0x48A3, 0x4391, 0x0608, 0x55F5, 0x106C, 0x1B5E, 0x5EC7,
+		0x54AA, 0x1133, 0x1A01, 0x5F98, 0x0C65, 0x49FC, 0x42CE, 0x0757,
+		0x20AD, 0x6534, 0x6E06, 0x2B9F, 0x7862, 0x3DFB, 0x36C9, 0x7350,
+		0x51D6, 0x144F, 0x1F7D, 0x5AE4, 0x0919, 0x4C80, 0x47B2, 0x022B,
+		0x25D1, 0x6048, 0x6B7A, 0x2EE3, 0x7D1E, 0x3887, 0x33B5, 0x762C,
+		0x7C41, 0x39D8, 0x32EA, 0x7773, 0x248E, 0x6117, 0x6A25, 0x2FBC,
+		0x0846, 0x4DDF, 0x46ED, 0x0374, 0x5089, 0x1510, 0x1E22, 0x5BBB,
+		0x0AF8, 0x4F61, 0x4453, 0x01CA, 0x5237, 0x17AE, 0x1C9C, 0x5905,
+		0x7EFF, 0x3B66, 0x3054, 0x75CD, 0x2630, 0x63A9, 0x689B, 0x2D02,
+		0x276F, 0x62F6, 0x69C4, 0x2C5D, 0x7FA0, 0x3A39, 0x310B, 0x7492,
+		0x5368, 0x16F1, 0x1DC3, 0x585A, 0x0BA7, 0x4E3E, 0x450C, 0x0095,
+	},
+	{15, 0x6815, false}: {
+		0x0000, 0x6815, 0x383F, 0x502A, 0x707E, 0x186B, 0x4841, 0x2054,
+		0x08E9, 0x60FC, 0x30D6, 0x58C3, 0x7897, 0x1082, 0x40A8, 0x28BD,
+		0x11D2, 0x79C7, 0x29ED, 0x41F8, 0x61AC, 0x09B9, 0x5993, 0x3186,
+		0x193B, 0x712E, 0x2104, 0x4911, 0x6945, 0x0150, 0x517A, 0x396F,
+		0x23A4, 0x4BB1, 0x1B9B, 0x738E, 0x53DA, 0x3BCF, 0x6BE5, 0x03F0,
+		0x2B4D, 0x4358, 0x1372, 0x7B67, 0x5B33, 0x3326, 0x630C, 0x0B19,
+		0x3276, 0x5A63, 0x0A49, 0x625C, 0x4208, 0x2A1D, 0x7A37, 0x1222,
+		0x3A9F, 0x528A, 0x02A0, 0x6AB5, 0x4AE1, 0x22F4, 0x72DE, 0x1ACB,
+		0x4748, 0x2F5D, 0x7F77, 0x1762, 0x3736, 0x5F23, 0x0F09, 0x671C,
+		0x4FA1, 0x27B4, 0x779E, 0x1F8B, 0x3FDF, 0x57CA, 0x07E0, 0x6FF5,
+		0x569A, 0x3E8F, 0x6EA5, 0x06B0, 0x26E4, 0x4EF1, 0x1EDB, 0x76CE,
+		0x5E73, 0x3666, 0x664C, 0x0E59, 0x2E0D, 0x4618, 0x1632, 0x7E27,
+		0x64EC, 0x0CF9, 0x5CD3, 0x34C6, 0x1492, 0x7C87, 0x2CAD, 0x44B8,
+		0x6C05, 0x0410, 0x543A, 0x3C2F, 0x1C7B, 0x746E, 0x2444, 0x4C51,
+		0x753E, 0x1D2B, 0x4D01, 0x2514, 0x0540, 0x6D55, 0x3D7F, 0x556A,
+		0x7DD7, 0x15C2, 0x45E8, 0x2DFD, 0x0DA9, 0x65BC, 0x3596, 0x5D83,
+		0x6685, 0x0E90, 0x5EBA, 0x36AF, 0x16FB, 0x7EEE, 0x2EC4, 0x46D1,
+		0x6E6C, 0x0679, 0x5653, 0x3E46, 0x1E12, 0x7607, 0x262D, 0x4E38,
+		0x7757, 0x1F42, 0x4F68, 0x277D, 0x0729, 0x6F3C, 0x3F16, 0x5703,
+		0x7FBE, 0x17AB, 0x4781, 0x2F94, 0x0FC0, 0x67D5, 0x37FF, 0x5FEA,
+		0x4521, 0x2D34, 0x7D1E, 0x150B, 0x355F, 0x5D4A, 0x0D60, 0x6575,
+		0x4DC8, 0x25DD, 0x75F7, 0x1DE2, 0x3DB6, 0x55A3, 0x0589, 0x6D9C,
+		0x54F3, 0x3CE6, 0x6CCC, 0x04D9, 0x248D, 0x4C98, 0x1CB2, 0x74A7,
+		0x5C1A, 0x340F, 0x6425, 0x0C30, 0x2C64, 0x4471, 0x145B, 0x7C4E,
+		0x21CD, 0x49D8, 0x19F2, 0x71E7, 0x51B3, 0x39A6, 0x698C, 0x0199,
+		0x2924, 0x4131, 0x111B, 0x790E, 0x595A, 0x314F, 0x6165, 0x0970,
+		0x301F, 0x580A, 0x0820, 0x6035, 0x4061, 0x2874, 0x785E, 0x104B,
+		0x38F6, 0x50E3, 0x00C9, 0x68DC, 0x4888, 0x209D, 0x70B7, 0x18A2,
+		0x0269, 0x6A7C, 0x3A56, 0x5243, 0x7217, 0x1A02, 0x4A28, 0x223D,
+		0x0A80, 0x6295, 0x32BF, 0x5AAA, 0x7AFE, 0x12EB, 0x42C1, 0x2AD4,
+		0x13BB, 0x7BAE, 0x2B84, 0x4391, 0x63C5, 0x0BD0, 0x5BFA, 0x33EF,
+		0x1B52, 0x7347, 0x236D, 0x4B78, 0x6B2C, 0x0339, 0x5313, 0x3B06,
+	},
+	{16, 0x8005, true}: {
+		0x0000, 0xC0C1, 0xC181, 0x0140, 0xC301, 0x03C0, 0x0280, 0xC241,
+		0xC601, 0x06C0, 0x0780, 0xC741, 0x0500, 0xC5C1, 0xC481, 0x0440,
+		0xCC01, 0x0CC0, 0x0D80, 0xCD41, 0x0F00, 0xCFC1, 0xCE81, 0x0E40,
+		0x0A00, 0xCAC1, 0xCB81, 0x0B40, 0xC901, 0x09C0, 0x0880, 0xC841,
+		0xD801, 0x18C0, 0x1980, 0xD941, 0x1B00, 0xDBC1, 0xDA81, 0x1A40,
+		0x1E00, 0xDEC1, 0xDF81, 0x1F40, 0xDD01, 0x1DC0, 0x1C80, 0xDC41,
+		0x1400, 0xD4C1, 0xD581, 0x1540, 0xD701, 0x17C0, 0x1680, 0xD641,
+		0xD201, 0x12C0, 0x1380, 0xD341, 0x1100, 0xD1C1, 0xD081, 0x1040,
+		0xF001, 0x30C0, 0x3180, 0xF141, 0x3300, 0xF3C1, 0xF281, 0x3240,
+		0x3600, 0xF6C1, 0xF781, 0x3740, 0xF501, 0x35C0, 0x3480, 0xF441,
+		0x3C00, 0xFCC1, 0xFD81, 0x3D40, 0xFF01, 0x3FC0, 0x3E80, 0xFE41,
+		0xFA01, 0x3AC0, 0x3B80, 0xFB41, 0x3900, 0xF9C1, 0xF881, 0x3840,
+		0x2800, 0xE8C1, 0xE981, 0x2940, 0xEB01, 0x2BC0, 0x2A80, 0xEA41,
+		0xEE01, 0x2EC0, 0x2F80, 0xEF41, 0x2D00, 0xEDC1, 0xEC81, 0x2C40,
+		0xE401, 0x24C0, 0x2580, 0xE541, 0x2700, 0xE7C1, 0xE681, 0x2640,
+		0x2200, 0xE2C1, 0xE381, 0x2340, 0xE101, 0x21C0, 0x2080, 0xE041,
+		0xA001, 0x60C0, 0x6180, 0xA141, 0x6300, 0xA3C1, 0xA281, 0x6240,
+		0x6600, 0xA6C1, 0xA781, 0x6740, 0xA501, 0x65C0, 0x6480, 0xA441,
+		0x6C00, 0xACC1, 0xAD81, 0x6D40, 0xAF01, 0x6FC0, 0x6E80, 0xAE41,
+		0xAA01, 0x6AC0, 0x6B80, 0xAB41, 0x6900, 0xA9C1, 0xA881, 0x6840,
+		0x7800, 0xB8C1, 0xB981, 0x7940, 0xBB01, 0x7BC0, 0x7A80, 0xBA41,
+		0xBE01, 0x7EC0, 0x7F80, 0xBF41, 0x7D00, 0xBDC1, 0xBC81, 0x7C40,
+		0xB401, 0x74C0, 0x7580, 0xB541, 0x7700, 0xB7C1, 0xB681, 0x7640,
+		0x7200, 0xB2C1, 0xB381, 0x7340, 0xB101, 0x71C0, 0x7080, 0xB041,
+		0x5000, 0x90C1, 0x9181, 0x5140, 0x9301, 0x53C0, 0x5280, 0x9241,
+		0x9601, 0x56C0, 0x5780, 0x9741, 0x5500, 0x95C1, 0x9481, 0x5440,
+		0x9C01, 0x5CC0, 0x5D80, 0x9D41, 0x5F00, 0x9FC1, 0x9E81, 0x5E40,
+		0x5A00, 0x9AC1, 0x9B81, 0x5B40, 0x9901, 0x59C0, 0x5880, 0x9841,
+		0x8801, 0x48C0, 0x4980, 0x8941, 0x4B00, 0x8BC1, 0x8A81, 0x4A40,
+		0x4E00, 0x8EC1, 0x8F81, 0x4F40, 0x8D01, 0x4DC0, 0x4C80, 0x8C41,
+		0x4400, 0x84C1, 0x8581, 0x4540, 0x8701, 0x47C0, 0x4680, 0x8641,
+		0x8201, 0x42C0, 0x4380, 0x8341, 0x4100, 0x81C1, 0x8081, 0x4040,
+	},
+	{16, 0xC867, false}: {
+		0x0000, 0xC867, 0x58A9, 0x90CE, 0xB152, 0x7935, 0xE9FB, 0x219C,
+		0xAAC3, 0x62A4, 0xF26A, 0x3A0D, 0x1B91, 0xD3F6, 0x4338, 0x8B5F,
+		0x9DE1, 0x5586, 0xC548, 0x0D2F, 0x2CB3, 0xE4D4, 0x741A, 0xBC7D,
+		0x3722, 0xFF45, 0x6F8B, 0xA7EC, 0x8670, 0x4E17, 0xDED9, 0x16BE,
+		0xF3A5, 0x3BC2, 0xAB0C, 0x636B, 0x42F7, 0x8A90, 0x1A5E, 0xD239,
+		0x5966, 0x9101, 0x01CF, 0xC9A8, 0xE834, 0x2053, 0xB09D, 0x78FA,
+		0x6E44, 0xA623, 0x36ED, 0xFE8A, 0xDF16, 0x1771, 0x87BF, 0x4FD8,
+		0xC487, 0x0CE0, 0x9C2E, 0x5449, 0x75D5, 0xBDB2, 0x2D7C, 0xE51B,
+		0x2F2D, 0xE74A, 0x7784, 0xBFE3, 0x9E7F, 0x5618, 0xC6D6, 0x0EB1,
+		0x85EE, 0x4D89, 0xDD47, 0x1520, 0x34BC, 0xFCDB, 0x6C15, 0xA472,
+		0xB2CC, 0x7AAB, 0xEA65, 0x2202, 0x039E, 0xCBF9, 0x5B37, 0x9350,
+		0x180F, 0xD068, 0x40A6, 0x88C1, 0xA95D, 0x613A, 0xF1F4, 0x3993,
+		0xDC88, 0x14EF, 0x8421, 0x4C46, 0x6DDA, 0xA5BD, 0x3573, 0xFD14,
+		0x764B, 0xBE2C, 0x2EE2, 0xE685, 0xC719, 0x0F7E, 0x9FB0, 0x57D7,
+		0x4169, 0x890E, 0x19C0, 0xD1A7, 0xF03B, 0x385C, 0xA892, 0x60F5,
+		0xEBAA, 0x23CD, 0xB303, 0x7B64, 0x5AF8, 0x929F, 0x0251, 0xCA36,
+		0x5E5A, 0x963D, 0x06F3, 0xCE94, 0xEF08, 0x276F, 0xB7A1, 0x7FC6,
+		0xF499, 0x3CFE, 0xAC30, 0x6457, 0x45CB, 0x8DAC, 0x1D62, 0xD505,
+		0xC3BB, 0x0BDC, 0x9B12, 0x5375, 0x72E9, 0xBA8E, 0x2A40, 0xE227,
+		0x6978, 0xA11F, 0x31D1, 0xF9B6, 0xD82A, 0x104D, 0x8083, 0x48E4,
+		0xADFF, 0x6598, 0xF556, 0x3D31, 0x1CAD, 0xD4CA, 0x4404, 0x8C63,
+		0x073C, 0xCF5B, 0x5F95, 0x97F2, 0xB66E, 0x7E09, 0xEEC7, 0x26A0,
+		0x301E, 0xF879, 0x68B7, 0xA0D0, 0x814C, 0x492B, 0xD9E5, 0x1182,
+		0x9ADD, 0x52BA, 0xC274, 0x0A13, 0x2B8F, 0xE3E8, 0x7326, 0xBB41,
+		0x7177, 0xB910, 0x29DE, 0xE1B9, 0xC025, 0x0842, 0x988C, 0x50EB,
+		0xDBB4, 0x13D3, 0x831D, 0x4B7A, 0x6AE6, 0xA281, 0x324F, 0xFA28,
+		0xEC96, 0x24F1, 0xB43F, 0x7C58, 0x5DC4, 0x95A3, 0x056D, 0xCD0A,
+		0x4655, 0x8E32, 0x1EFC, 0xD69B, 0xF707, 0x3F60, 0xAFAE, 0x67C9,
+		0x82D2, 0x4AB5, 0xDA7B, 0x121C, 0x3380, 0xFBE7, 0x6B29, 0xA34E,
+		0x2811, 0xE076, 0x70B8, 0xB8DF, 0x9943, 0x5124, 0xC1EA, 0x098D,
+		0x1F33, 0xD754, 0x479A, 0x8FFD, 0xAE61, 0x6606, 0xF6C8, 0x3EAF,
+		0xB5F0, 0x7D97, 0xED59, 0x253E, 0x04A2, 0xCCC5, 0x5C0B, 0x946C,
+	},
+	{16, 0x8005, false}: {
+		0x0000, 0x8005, 0x800F, 0x000A, 0x801B, 0x001E, 0x0014, 0x8011,
+		0x8033, 0x0036, 0x003C, 0x8039, 0x0028, 0x802D, 0x8027, 0x0022,
+		0x8063, 0x0066, 0x006C, 0x8069, 0x0078, 0x807D, 0x8077, 0x0072,
+		0x0050, 0x8055, 0x805F, 0x005A, 0x804B, 0x004E, 0x0044, 0x8041,
+		0x80C3, 0x00C6, 0x00CC, 0x80C9, 0x00D8, 0x80DD, 0x80D7, 0x00D2,
+		0x00F0, 0x80F5, 0x80FF, 0x00FA, 0x80EB, 0x00EE, 0x00E4, 0x80E1,
+		0x00A0, 0x80A5, 0x80AF, 0x00AA, 0x80BB, 0x00BE, 0x00B4, 0x80B1,
+		0x8093, 0x0096, 0x009C, 0x8099, 0x0088, 0x808D, 0x8087, 0x0082,
+		0x8183, 0x0186, 0x018C, 0x8189, 0x0198, 0x819D, 0x8197, 0x0192,
+		0x01B0, 0x81B5, 0x81BF, 0x01BA, 0x81AB, 0x01AE, 0x01A4, 0x81A1,
+		0x01E0, 0x81E5, 0x81EF, 0x01EA, 0x81FB, 0x01FE, 0x01F4, 0x81F1,
+		0x81D3, 0x01D6, 0x01DC, 0x81D9, 0x01C8, 0x81CD, 0x81C7, 0x01C2,
+		0x0140, 0x8145, 0x814F, 0x014A, 0x815B, 0x015E, 0x0154, 0x8151,
+		0x8173, 0x0176, 0x017C, 0x8179, 0x0168, 0x816D, 0x8167, 0x0162,
+		0x8123, 0x0126, 0x012C, 0x8129, 0x0138, 0x813D, 0x8137, 0x0132,
+		0x0110, 0x8115, 0x811F, 0x011A, 0x810B, 0x010E, 0x0104, 0x8101,
+		0x8303, 0x0306, 0x030C, 0x8309, 0x0318, 0x831D, 0x8317, 0x0312,
+		0x0330, 0x8335, 0x833F, 0x033A, 0x832B, 0x032E, 0x0324, 0x8321,
+		0x0360, 0x8365, 0x836F, 0x036A, 0x837B, 0x037E, 0x0374, 0x8371,
+		0x8353, 0x0356, 0x035C, 0x8359, 0x0348, 0x834D, 0x8347, 0x0342,
+		0x03C0, 0x83C5, 0x83CF, 0x03CA, 0x83DB, 0x03DE, 0x03D4, 0x83D1,
+		0x83F3, 0x03F6, 0x03FC, 0x83F9, 0x03E8, 0x83ED, 0x83E7, 0x03E2,
+		0x83A3, 0x03A6, 0x03AC, 0x83A9, 0x03B8, 0x83BD, 0x83B7, 0x03B2,
+		0x0390, 0x8395, 0x839F, 0x039A, 0x838B, 0x038E, 0x0384, 0x8381,
+		0x0280, 0x8285, 0x828F, 0x028A, 0x829B, 0x029E, 0x0294, 0x8291,
+		0x82B3, 0x02B6, 0x02BC, 0x82B9, 0x02A8, 0x82AD, 0x82A7, 0x02A2,
+		0x82E3, 0x02E6, 0x02EC, 0x82E9, 0x02F8, 0x82FD, 0x82F7, 0x02F2,
+		0x02D0, 0x82D5, 0x82DF, 0x02DA, 0x82CB, 0x02CE, 0x02C4, 0x82C1,
+		0x8243, 0x0246, 0x024C, 0x8249, 0x0258, 0x825D, 0x8257, 0x0252,
+		0x0270, 0x8275, 0x827F, 0x027A, 0x826B, 0x026E, 0x0264, 0x8261,
+		0x0220, 0x8225, 0x822F, 0x022A, 0x823B, 0x023E, 0x0234, 0x8231,
+		0x8213, 0x0216, 0x021C, 0x8219, 0x0208, 0x820D, 0x8207, 0x0202,
+	},
+	{16, 0x589, false}: {
+		0x0000, 0x0589, 0x0B12, 0x0E9B, 0x1624, 0x13AD, 0x1D36, 0x18BF,
+		0x2C48, 0x29C1, 0x275A, 0x22D3, 0x3A6C, 0x3FE5, 0x317E, 0x34F7,
+		0x5890, 0x5D19, 0x5382, 0x560B, 0x4EB4, 0x4B3D, 0x45A6, 0x402F,
+		0x74D8, 0x7151, 0x7FCA, 0x7A43, 0x62FC, 0x6775, 0x69EE, 0x6C67,
+		0xB120, 0xB4A9, 0xBA32, 0xBFBB, 0xA704, 0xA28D, 0xAC16, 0xA99F,
+		0x9D68, 0x98E1, 0x967A, 0x93F3, 0x8B4C, 0x8EC5, 0x805E, 0x85D7,
+		0xE9B0, 0xEC39, 0xE2A2, 0xE72B, 0xFF94, 0xFA1D, 0xF486, 0xF10F,
+		0xC5F8, 0xC071, 0xCEEA, 0xCB63, 0xD3DC, 0xD655, 0xD8CE, 0xDD47,
+		0x67C9, 0x6240, 0x6CDB, 0x6952, 0x71ED, 0x7464, 0x7AFF, 0x7F76,
+		0x4B81, 0x4E08, 0x4093, 0x451A, 0x5DA5, 0x582C, 0x56B7, 0x533E,
+		0x3F59, 0x3AD0, 0x344B, 0x31C2, 0x297D, 0x2CF4, 0x226F, 0x27E6,
+		0x1311, 0x1698, 0x1803, 0x1D8A, 0x0535, 0x00BC, 0x0E27, 0x0BAE,
+		0xD6E9, 0xD360, 0xDDFB, 0xD872, 0xC0CD, 0xC544, 0xCBDF, 0xCE56,
+		0xFAA1, 0xFF28, 0xF1B3, 0xF43A, 0xEC85, 0xE90C, 0xE797, 0xE21E,
+		0x8E79, 0x8BF0, 0x856B, 0x80E2, 0x985D, 0x9DD4, 0x934F, 0x96C6,
+		0xA231, 0xA7B8, 0xA923, 0xACAA, 0xB415, 0xB19C, 0xBF07, 0xBA8E,
+		0xCF92, 0xCA1B, 0xC480, 0xC109, 0xD9B6, 0xDC3F, 0xD2A4, 0xD72D,
+		0xE3DA, 0xE653, 0xE8C8, 0xED41, 0xF5FE, 0xF077, 0xFEEC, 0xFB65,
+		0x9702, 0x928B, 0x9C10, 0x9999, 0x8126, 0x84AF, 0x8A34, 0x8FBD,
+		0xBB4A, 0xBEC3, 0xB058, 0xB5D1, 0xAD6E, 0xA8E7, 0xA67C, 0xA3F5,
+		0x7EB2, 0x7B3B, 0x75A0, 0x7029, 0x6896, 0x6D1F, 0x6384, 0x660D,
+		0x52FA, 0x5773, 0x59E8, 0x5C61, 0x44DE, 0x4157, 0x4FCC, 0x4A45,
+		0x2622, 0x23AB, 0x2D30, 0x28B9, 0x3006, 0x358F, 0x3B14, 0x3E9D,
+		0x0A6A, 0x0FE3, 0x0178, 0x04F1, 0x1C4E, 0x19C7, 0x175C, 0x12D5,
+		0xA85B, 0xADD2, 0xA349, 0xA6C0, 0xBE7F, 0xBBF6, 0xB56D, 0xB0E4,
+		0x8413, 0x819A, 0x8F01, 0x8A88, 0x9237, 0x97BE, 0x9925, 0x9CAC,
+		0xF0CB, 0xF542, 0xFBD9, 0xFE50, 0xE6EF, 0xE366, 0xEDFD, 0xE874,
+		0xDC83, 0xD90A, 0xD791, 0xD218, 0xCAA7, 0xCF2E, 0xC1B5, 0xC43C,
+		0x197B, 0x1CF2, 0x1269, 0x17E0, 0x0F5F, 0x0AD6, 0x044D, 0x01C4,
+		0x3533, 0x30BA, 0x3E21, 0x3BA8, 0x2317, 0x269E, 0x2805, 0x2D8C,
+		0x41EB, 0x4462, 0x4AF9, 0x4F70, 0x57CF, 0x5246, 0x5CDD, 0x5954,
+		0x6DA3, 0x682A, 0x66B1, 0x6338, 0x7B87, 0x7E0E, 0x7095, 0x751C,
+	},
+	{16, 0x3D65, true}: {
+		0x0000, 0x365E, 0x6CBC, 0x5AE2, 0xD978, 0xEF26, 0xB5C4, 0x839A,
+		0xFF89, 0xC9D7, 0x9335, 0xA56B, 0x26F1, 0x10AF, 0x4A4D, 0x7C13,
+		0xB26B, 0x8435, 0xDED7, 0xE889, 0x6B13, 0x5D4D, 0x07AF, 0x31F1,
+		0x4DE2, 0x7BBC, 0x215E, 0x1700, 0x949A, 0xA2C4, 0xF826, 0xCE78,
+		0x29AF, 0x1FF1, 0x4513, 0x734D, 0xF0D7, 0xC689, 0x9C6B, 0xAA35,
+		0xD626, 0xE078, 0xBA9A, 0x8CC4, 0x0F5E, 0x3900, 0x63E2, 0x55BC,
+		0x9BC4, 0xAD9A, 0xF778, 0xC126, 0x42BC, 0x74E2, 0x2E00, 0x185E,
+		0x644D, 0x5213, 0x08F1, 0x3EAF, 0xBD35, 0x8B6B, 0xD189, 0xE7D7,
+		0x535E, 0x6500, 0x3FE2, 0x09BC, 0x8A26, 0xBC78, 0xE69A, 0xD0C4,
+		0xACD7, 0x9A89, 0xC06B, 0xF635, 0x75AF, 0x43F1, 0x1913, 0x2F4D,
+		0xE135, 0xD76B, 0x8D89, 0xBBD7, 0x384D, 0x0E13, 0x54F1, 0x62AF,
+		0x1EBC, 0x28E2, 0x7200, 0x445E, 0xC7C4, 0xF19A, 0xAB78, 0x9D26,
+		0x7AF1, 0x4CAF, 0x164D, 0x2013, 0xA389, 0x95D7, 0xCF35, 0xF96B,
+		0x8578, 0xB326, 0xE9C4, 0xDF9A, 0x5C00, 0x6A5E, 0x30BC, 0x06E2,
+		0xC89A, 0xFEC4, 0xA426, 0x9278, 0x11E2, 0x27BC, 0x7D5E, 0x4B00,
+		0x3713, 0x014D, 0x5BAF, 0x6DF1, 0xEE6B, 0xD835, 0x82D7, 0xB489,
+		0xA6BC, 0x90E2, 0xCA00, 0xFC5E, 0x7FC4, 0x499A, 0x1378, 0x2526,
+		0x5935, 0x6F6B, 0x3589, 0x03D7, 0x804D, 0xB613, 0xECF1, 0xDAAF,
+		0x14D7, 0x2289, 0x786B, 0x4E35, 0xCDAF, 0xFBF1, 0xA113, 0x974D,
+		0xEB5E, 0xDD00, 0x87E2, 0xB1BC, 0x3226, 0x0478, 0x5E9A, 0x68C4,
+		0x8F13, 0xB94D, 0xE3AF, 0xD5F1, 0x566B, 0x6035, 0x3AD7, 0x0C89,
+		0x709A, 0x46C4, 0x1C26, 0x2A78, 0xA9E2, 0x9FBC, 0xC55E, 0xF300,
+		0x3D78, 0x0B26, 0x51C4, 0x679A, 0xE400, 0xD25E, 0x88BC, 0xBEE2,
+		0xC2F1, 0xF4AF, 0xAE4D, 0x9813, 0x1B89, 0x2DD7, 0x7735, 0x416B,
+		0xF5E2, 0xC3BC, 0x995E, 0xAF00, 0x2C9A, 0x1AC4, 0x4026, 0x7678,
+		0x0A6B, 0x3C35, 0x66D7, 0x5089, 0xD313, 0xE54D, 0xBFAF, 0x89F1,
+		0x4789, 0x71D7, 0x2B35, 0x1D6B, 0x9EF1, 0xA8AF, 0xF24D, 0xC413,
+		0xB800, 0x8E5E, 0xD4BC, 0xE2E2, 0x6178, 0x5726, 0x0DC4, 0x3B9A,
+		0xDC4D, 0xEA13, 0xB0F1, 0x86AF, 0x0535, 0x336B, 0x6989, 0x5FD7,
+		0x23C4, 0x159A, 0x4F78, 0x7926, 0xFABC, 0xCCE2, 0x9600, 0xA05E,
+		0x6E26, 0x5878, 0x029A, 0x34C4, 0xB75E, 0x8100, 0xDBE2, 0xEDBC,
+		0x91AF, 0xA7F1, 0xFD13, 0xCB4D, 0x48D7, 0x7E89, 0x246B, 0x1235,
+	},
+	{16, 0x3D65, false}: {
+		0x0000, 0x3D65, 0x7ACA, 0x47AF, 0xF594, 0xC8F1, 0x8F5E, 0xB23B,
+		0xD64D, 0xEB28, 0xAC87, 0x91E2, 0x23D9, 0x1EBC, 0x5913, 0x6476,
+		0x91FF, 0xAC9A, 0xEB35, 0xD650, 0x646B, 0x590E, 0x1EA1, 0x23C4,
+		0x47B2, 0x7AD7, 0x3D78, 0x001D, 0xB226, 0x8F43, 0xC8EC, 0xF589,
+		0x1E9B, 0x23FE, 0x6451, 0x5934, 0xEB0F, 0xD66A, 0x91C5, 0xACA0,
+		0xC8D6, 0xF5B3, 0xB21C, 0x8F79, 0x3D42, 0x0027, 0x4788, 0x7AED,
+		0x8F64, 0xB201, 0xF5AE, 0xC8CB, 0x7AF0, 0x4795, 0x003A, 0x3D5F,
+		0x5929, 0x644C, 0x23E3, 0x1E86, 0xACBD, 0x91D8, 0xD677, 0xEB12,
+		0x3D36, 0x0053, 0x47FC, 0x7A99, 0xC8A2, 0xF5C7, 0xB268, 0x8F0D,
+		0xEB7B, 0xD61E, 0x91B1, 0xACD4, 0x1EEF, 0x238A, 0x6425, 0x5940,
+		0xACC9, 0x91AC, 0xD603, 0xEB66, 0x595D, 0x6438, 0x2397, 0x1EF2,
+		0x7A84, 0x47E1, 0x004E, 0x3D2B, 0x8F10, 0xB275, 0xF5DA, 0xC8BF,
+		0x23AD, 0x1EC8, 0x5967, 0x6402, 0xD639, 0xEB5C, 0xACF3, 0x9196,
+		0xF5E0, 0xC885, 0x8F2A, 0xB24F, 0x0074, 0x3D11, 0x7ABE, 0x47DB,
+		0xB252, 0x8F37, 0xC898, 0xF5FD, 0x47C6, 0x7AA3, 0x3D0C, 0x0069,
+		0x641F, 0x597A, 0x1ED5, 0x23B0, 0x918B, 0xACEE, 0xEB41, 0xD624,
+		0x7A6C, 0x4709, 0x00A6, 0x3DC3, 0x8FF8, 0xB29D, 0xF532, 0xC857,
+		0xAC21, 0x9144, 0xD6EB, 0xEB8E, 0x59B5, 0x64D0, 0x237F, 0x1E1A,
+		0xEB93, 0xD6F6, 0x9159, 0xAC3C, 0x1E07, 0x2362, 0x64CD, 0x59A8,
+		0x3DDE, 0x00BB, 0x4714, 0x7A71, 0xC84A, 0xF52F, 0xB280, 0x8FE5,
+		0x64F7, 0x5992, 0x1E3D, 0x2358, 0x9163, 0xAC06, 0xEBA9, 0xD6CC,
+		0xB2BA, 0x8FDF, 0xC870, 0xF515, 0x472E, 0x7A4B, 0x3DE4, 0x0081,
+		0xF508, 0xC86D, 0x8FC2, 0xB2A7, 0x009C, 0x3DF9, 0x7A56, 0x4733,
+		0x2345, 0x1E20, 0x598F, 0x64EA, 0xD6D1, 0xEBB4, 0xAC1B, 0x917E,
+		0x475A, 0x7A3F, 0x3D90, 0x00F5, 0xB2CE, 0x8FAB, 0xC804, 0xF561,
+		0x9117, 0xAC72, 0xEBDD, 0xD6B8, 0x6483, 0x59E6, 0x1E49, 0x232C,
+		0xD6A5, 0xEBC0, 0xAC6F, 0x910A, 0x2331, 0x1E54, 0x59FB, 0x649E,
+		0x00E8, 0x3D8D, 0x7A22, 0x4747, 0xF57C, 0xC819, 0x8FB6, 0xB2D3,
+		0x59C1, 0x64A4, 0x230B, 0x1E6E, 0xAC55, 0x9130, 0xD69F, 0xEBFA,
+		0x8F8C, 0xB2E9, 0xF546, 0xC823, 0x7A18, 0x477D, 0x00D2, 0x3DB7,
+		0xC83E, 0xF55B, 0xB2F4, 0x8F91, 0x3DAA, 0x00CF, 0x4760, 0x7A05,
+		0x1E73, 0x2316, 0x64B9, 0x59DC, 0xEBE7, 0xD682, 0x912D, 0xAC48,
+	},
+	{16, 0x1021, false}: {
+		0x0000, 0x1021, 0x2042, 0x3063, 0x4084, 0x50A5, 0x60C6, 0x70E7,
+		0x8108, 0x9129, 0xA14A, 0xB16B, 0xC18C, 0xD1AD, 0xE1CE, 0xF1EF,
+		0x1231, 0x0210, 0x3273, 0x2252, 0x52B5, 0x4294, 0x72F7, 0x62D6,
+		0x9339, 0x8318, 0xB37B, 0xA35A, 0xD3BD, 0xC39C, 0xF3FF, 0xE3DE,
+		0x2462, 0x3443, 0x0420, 0x1401, 0x64E6, 0x74C7, 0x44A4, 0x5485,
+		0xA56A, 0xB54B, 0x8528, 0x9509, 0xE5EE, 0xF5CF, 0xC5AC, 0xD58D,
+		0x3653, 0x2672, 0x1611, 0x0630, 0x76D7, 0x66F6, 0x5695, 0x46B4,
+		0xB75B, 0xA77A, 0x9719, 0x8738, 0xF7DF, 0xE7FE, 0xD79D, 0xC7BC,
+		0x48C4, 0x58E5, 0x6886, 0x78A7, 0x0840, 0x1861, 0x2802, 0x3823,
+		0xC9CC, 0xD9ED, 0xE98E, 0xF9AF, 0x8948, 0x9969, 0xA90A, 0xB92B,
+		0x5AF5, 0x4AD4, 0x7AB7, 0x6A96, 0x1A71, 0x0A50, 0x3A33, 0x2A12,
+		0xDBFD, 0xCBDC, 0xFBBF, 0xEB9E, 0x9B79, 0x8B58, 0xBB3B, 0xAB1A,
+		0x6CA6, 0x7C87, 0x4CE4, 0x5CC5, 0x2C22, 0x3C03, 0x0C60, 0x1C41,
+		0xEDAE, 0xFD8F, 0xCDEC, 0xDDCD, 0xAD2A, 0xBD0B, 0x8D68, 0x9D49,
+		0x7E97, 0x6EB6, 0x5ED5, 0x4EF4, 0x3E13, 0x2E32, 0x1E51, 0x0E70,
+		0xFF9F, 0xEFBE, 0xDFDD, 0xCFFC, 0xBF1B, 0xAF3A, 0x9F59, 0x8F78,
+		0x9188, 0x81A9, 0xB1CA, 0xA1EB, 0xD10C, 0xC12D, 0xF14E, 0xE16F,
+		0x1080, 0x00A1, 0x30C2, 0x20E3, 0x5004, 0x4025, 0x7046, 0x6067,
+		0x83B9, 0x9398, 0xA3FB, 0xB3DA, 0xC33D, 0xD31C, 0xE37F, 0xF35E,
+		0x02B1, 0x1290, 0x22F3, 0x32D2, 0x4235, 0x5214, 0x6277, 0x7256,
+		0xB5EA, 0xA5CB, 0x95A8, 0x8589, 0xF56E, 0xE54F, 0xD52C, 0xC50D,
+		0x34E2, 0x24C3, 0x14A0, 0x0481, 0x7466, 0x6447, 0x5424, 0x4405,
+		0xA7DB, 0xB7FA, 0x8799, 0x97B8, 0xE75F, 0xF77E, 0xC71D, 0xD73C,
+		0x26D3, 0x36F2, 0x0691, 0x16B0, 0x6657, 0x7676, 0x4615, 0x5634,
+		0xD94C, 0xC96D, 0xF90E, 0xE92F, 0x99C8, 0x89E9, 0xB98A, 0xA9AB,
+		0x5844, 0x4865, 0x7806, 0x6827, 0x18C0, 0x08E1, 0x3882, 0x28A3,
+		0xCB7D, 0xDB5C, 0xEB3F, 0xFB1E, 0x8BF9, 0x9BD8, 0xABBB, 0xBB9A,
+		0x4A75, 0x5A54, 0x6A37, 0x7A16, 0x0AF1, 0x1AD0, 0x2AB3, 0x3A92,
+		0xFD2E, 0xED0F, 0xDD6C, 0xCD4D, 0xBDAA, 0xAD8B, 0x9DE8, 0x8DC9,
+		0x7C26, 0x6C07, 0x5C64, 0x4C45, 0x3CA2, 0x2C83, 0x1CE0, 0x0CC1,
+		0xEF1F, 0xFF3E, 0xCF5D, 0xDF7C, 0xAF9B, 0xBFBA, 0x8FD9, 0x9FF8,
+		0x6E17, 0x7E36, 0x4E55, 0x5E74, 0x2E93, 0x3EB2, 0x0ED1, 0x1EF0,
+	},
+	{16, 0x1021, true}: {
+		0x0000, 0x1189, 0x2312, 0x329B, 0x4624, 0x57AD, 0x6536, 0x74BF,
+		0x8C48, 0x9DC1, 0xAF5A, 0xBED3, 0xCA6C, 0xDBE5, 0xE97E, 0xF8F7,
+		0x1081, 0x0108, 0x3393, 0x221A, 0x56A5, 0x472C, 0x75B7, 0x643E,
+		0x9CC9, 0x8D40, 0xBFDB, 0xAE52, 0xDAED, 0xCB64, 0xF9FF, 0xE876,
+		0x2102, 0x308B, 0x0210, 0x1399, 0x6726, 0x76AF, 0x4434, 0x55BD,
+		0xAD4A, 0xBCC3, 0x8E58, 0x9FD1, 0xEB6E, 0xFAE7, 0xC87C, 0xD9F5,
+		0x3183, 0x200A, 0x1291, 0x0318, 0x77A7, 0x662E, 0x54B5, 0x453C,
+		0xBDCB, 0xAC42, 0x9ED9, 0x8F50, 0xFBEF, 0xEA66, 0xD8FD, 0xC974,
+		0x4204, 0x538D, 0x6116, 0x709F, 0x0420, 0x15A9, 0x2732, 0x36BB,
+		0xCE4C, 0xDFC5, 0xED5E, 0xFCD7, 0x8868, 0x99E1, 0xAB7A, 0xBAF3,
+		0x5285, 0x430C, 0x7197, 0x601E, 0x14A1, 0x0528, 0x37B3, 0x263A,
+		0xDECD, 0xCF44, 0xFDDF, 0xEC56, 0x98E9, 0x8960, 0xBBFB, 0xAA72,
+		0x6306, 0x728F, 0x4014, 0x519D, 0x2522, 0x34AB, 0x0630, 0x17B9,
+		0xEF4E, 0xFEC7, 0xCC5C, 0xDDD5, 0xA96A, 0xB8E3, 0x8A78, 0x9BF1,
+		0x7387, 0x620E, 0x5095, 0x411C, 0x35A3, 0x242A, 0x16B1, 0x0738,
+		0xFFCF, 0xEE46, 0xDCDD, 0xCD54, 0xB9EB, 0xA862, 0x9AF9, 0x8B70,
+		0x8408, 0x9581, 0xA71A, 0xB693, 0xC22C, 0xD3A5, 0xE13E, 0xF0B7,
+		0x0840, 0x19C9, 0x2B52, 0x3ADB, 0x4E64, 0x5FED, 0x6D76, 0x7CFF,
+		0x9489, 0x8500, 0xB79B, 0xA612, 0xD2AD, 0xC324, 0xF1BF, 0xE036,
+		0x18C1, 0x0948, 0x3BD3, 0x2A5A, 0x5EE5, 0x4F6C, 0x7DF7, 0x6C7E,
+		0xA50A, 0xB483, 0x8618, 0x9791, 0xE32E, 0xF2A7, 0xC03C, 0xD1B5,
+		0x2942, 0x38CB, 0x0A50, 0x1BD9, 0x6F66, 0x7EEF, 0x4C74, 0x5DFD,
+		0xB58B, 0xA402, 0x9699, 0x8710, 0xF3AF, 0xE226, 0xD0BD, 0xC134,
+		0x39C3, 0x284A, 0x1AD1, 0x0B58, 0x7FE7, 0x6E6E, 0x5CF5, 0x4D7C,
+		0xC60C, 0xD785, 0xE51E, 0xF497, 0x8028, 0x91A1, 0xA33A, 0xB2B3,
+		0x4A44, 0x5BCD, 0x6956, 0x78DF, 0x0C60, 0x1DE9, 0x2F72, 0x3EFB,
+		0xD68D, 0xC704, 0xF59F, 0xE416, 0x90A9, 0x8120, 0xB3BB, 0xA232,
+		0x5AC5, 0x4B4C, 0x79D7, 0x685E, 0x1CE1, 0x0D68, 0x3FF3, 0x2E7A,
+		0xE70E, 0xF687, 0xC41C, 0xD595, 0xA12A, 0xB0A3, 0x8238, 0x93B1,
+		0x6B46, 0x7ACF, 0x4854, 0x59DD, 0x2D62, 0x3CEB, 0x0E70, 0x1FF9,
+		0xF78F, 0xE606, 0xD49D, 0xC514, 0xB1AB, 0xA022, 0x92B9, 0x8330,
+		0x7BC7, 0x6A4E, 0x58D5, 0x495C, 0x3DE3, 0x2C6A, 0x1EF1, 0x0F78,
+	},
+	{16, 0x6F63, false}: {
+		0x0000, 0x6F63, 0xDEC6, 0xB1A5, 0xD2EF, 0xBD8C, 0x0C29, 0x634A,
+		0xCABD, 0xA5DE, 0x147B, 0x7B18, 0x1852, 0x7731, 0xC694, 0xA9F7,
+		0xFA19, 0x957A, 0x24DF, 0x4BBC, 0x28F6, 0x4795, 0xF630, 0x9953,
+		0x30A4, 0x5FC7, 0xEE62, 0x8101, 0xE24B, 0x8D28, 0x3C8D, 0x53EE,
+		0x9B51, 0xF432, 0x4597, 0x2AF4, 0x49BE, 0x26DD, 0x9778, 0xF81B,
+		0x51EC, 0x3E8F, 0x8F2A, 0xE049, 0x8303, 0xEC60, 0x5DC5, 0x32A6,
+		0x6148, 0x0E2B, 0xBF8E, 0xD0ED, 0xB3A7, 0xDCC4, 0x6D61, 0x0202,
+		0xABF5, 0xC496, 0x7533, 0x1A50, 0x791A, 0x1679, 0xA7DC, 0xC8BF,
+		0x59C1, 0x36A2, 0x8707, 0xE864, 0x8B2E, 0xE44D, 0x55E8, 0x3A8B,
+		0x937C, 0xFC1F, 0x4DBA, 0x22D9, 0x4193, 0x2EF0, 0x9F55, 0xF036,
+		0xA3D8, 0xCCBB, 0x7D1E, 0x127D, 0x7137, 0x1E54, 0xAFF1, 0xC092,
+		0x6965, 0x0606, 0xB7A3, 0xD8C0, 0xBB8A, 0xD4E9, 0x654C, 0x0A2F,
+		0xC290, 0xADF3, 0x1C56, 0x7335, 0x107F, 0x7F1C, 0xCEB9, 0xA1DA,
+		0x082D, 0x674E, 0xD6EB, 0xB988, 0xDAC2, 0xB5A1, 0x0404, 0x6B67,
+		0x3889, 0x57EA, 0xE64F, 0x892C, 0xEA66, 0x8505, 0x34A0, 0x5BC3,
+		0xF234, 0x9D57, 0x2CF2, 0x4391, 0x20DB, 0x4FB8, 0xFE1D, 0x917E,
+		0xB382, 0xDCE1, 0x6D44, 0x0227, 0x616D, 0x0E0E, 0xBFAB, 0xD0C8,
+		0x793F, 0x165C, 0xA7F9, 0xC89A, 0xABD0, 0xC4B3, 0x7516, 0x1A75,
+		0x499B, 0x26F8, 0x975D, 0xF83E, 0x9B74, 0xF417, 0x45B2, 0x2AD1,
+		0x8326, 0xEC45, 0x5DE0, 0x3283, 0x51C9, 0x3EAA, 0x8F0F, 0xE06C,
+		0x28D3, 0x47B0, 0xF615, 0x9976, 0xFA3C, 0x955F, 0x24FA, 0x4B99,
+		0xE26E, 0x8D0D, 0x3CA8, 0x53CB, 0x3081, 0x5FE2, 0xEE47, 0x8124,
+		0xD2CA, 0xBDA9, 0x0C0C, 0x636F, 0x0025, 0x6F46, 0xDEE3, 0xB180,
+		0x1877, 0x7714, 0xC6B1, 0xA9D2, 0xCA98, 0xA5FB, 0x145E, 0x7B3D,
+		0xEA43, 0x8520, 0x3485, 0x5BE6, 0x38AC, 0x57CF, 0xE66A, 0x8909,
+		0x20FE, 0x4F9D, 0xFE38, 0x915B, 0xF211, 0x9D72, 0x2CD7, 0x43B4,
+		0x105A, 0x7F39, 0xCE9C, 0xA1FF, 0xC2B5, 0xADD6, 0x1C73, 0x7310,
+		0xDAE7, 0xB584, 0x0421, 0x6B42, 0x0808, 0x676B, 0xD6CE, 0xB9AD,
+		0x7112, 0x1E71, 0xAFD4, 0xC0B7, 0xA3FD, 0xCC9E, 0x7D3B, 0x1258,
+		0xBBAF, 0xD4CC, 0x6569, 0x0A0A, 0x6940, 0x0623, 0xB786, 0xD8E5,
+		0x8B0B, 0xE468, 0x55CD, 0x3AAE, 0x59E4, 0x3687, 0x8722, 0xE841,
+		0x41B6, 0x2ED5, 0x9F70, 0xF013, 0x9359, 0xFC3A, 0x4D9F, 0x22FC,
+	},
+	{16, 0x5935, false}: {
+		0x0000, 0x5935, 0xB26A, 0xEB5F, 0x3DE1, 0x64D4, 0x8F8B, 0xD6BE,
+		0x7BC2, 0x22F7, 0xC9A8, 0x909D, 0x4623, 0x1F16, 0xF449, 0xAD7C,
+		0xF784, 0xAEB1, 0x45EE, 0x1CDB, 0xCA65, 0x9350, 0x780F, 0x213A,
+		0x8C46, 0xD573, 0x3E2C, 0x6719, 0xB1A7, 0xE892, 0x03CD, 0x5AF8,
+		0xB63D, 0xEF08, 0x0457, 0x5D62, 0x8BDC, 0xD2E9, 0x39B6, 0x6083,
+		0xCDFF, 0x94CA, 0x7F95, 0x26A0, 0xF01E, 0xA92B, 0x4274, 0x1B41,
+		0x41B9, 0x188C, 0xF3D3, 0xAAE6, 0x7C58, 0x256D, 0xCE32, 0x9707,
+		0x3A7B, 0x634E, 0x8811, 0xD124, 0x079A, 0x5EAF, 0xB5F0, 0xECC5,
+		0x354F, 0x6C7A, 0x8725, 0xDE10, 0x08AE, 0x519B, 0xBAC4, 0xE3F1,
+		0x4E8D, 0x17B8, 0xFCE7, 0xA5D2, 0x736C, 0x2A59, 0xC106, 0x9833,
+		0xC2CB, 0x9BFE, 0x70A1, 0x2994, 0xFF2A, 0xA61F, 0x4D40, 0x1475,
+		0xB909, 0xE03C, 0x0B63, 0x5256, 0x84E8, 0xDDDD, 0x3682, 0x6FB7,
+		0x8372, 0xDA47, 0x3118, 0x682D, 0xBE93, 0xE7A6, 0x0CF9, 0x55CC,
+		0xF8B0, 0xA185, 0x4ADA, 0x13EF, 0xC551, 0x9C64, 0x773B, 0x2E0E,
+		0x74F6, 0x2DC3, 0xC69C, 0x9FA9, 0x4917, 0x1022, 0xFB7D, 0xA248,
+		0x0F34, 0x5601, 0xBD5E, 0xE46B, 0x32D5, 0x6BE0, 0x80BF, 0xD98A,
+		0x6A9E, 0x33AB, 0xD8F4, 0x81C1, 0x577F, 0x0E4A, 0xE515, 0xBC20,
+		0x115C, 0x4869, 0xA336, 0xFA03, 0x2CBD, 0x7588, 0x9ED7, 0xC7E2,
+		0x9D1A, 0xC42F, 0x2F70, 0x7645, 0xA0FB, 0xF9CE, 0x1291, 0x4BA4,
+		0xE6D8, 0xBFED, 0x54B2, 0x0D87, 0xDB39, 0x820C, 0x6953, 0x3066,
+		0xDCA3, 0x8596, 0x6EC9, 0x37FC, 0xE142, 0xB877, 0x5328, 0x0A1D,
+		0xA761, 0xFE54, 0x150B, 0x4C3E, 0x9A80, 0xC3B5, 0x28EA, 0x71DF,
+		0x2B27, 0x7212, 0x994D, 0xC078, 0x16C6, 0x4FF3, 0xA4AC, 0xFD99,
+		0x50E5, 0x09D0, 0xE28F, 0xBBBA, 0x6D04, 0x3431, 0xDF6E, 0x865B,
+		0x5FD1, 0x06E4, 0xEDBB, 0xB48E, 0x6230, 0x3B05, 0xD05A, 0x896F,
+		0x2413, 0x7D26, 0x9679, 0xCF4C, 0x19F2, 0x40C7, 0xAB98, 0xF2AD,
+		0xA855, 0xF160, 0x1A3F, 0x430A, 0x95B4, 0xCC81, 0x27DE, 0x7EEB,
+		0xD397, 0x8AA2, 0x61FD, 0x38C8, 0xEE76, 0xB743, 0x5C1C, 0x0529,
+		0xE9EC, 0xB0D9, 0x5B86, 0x02B3, 0xD40D, 0x8D38, 0x6667, 0x3F52,
+		0x922E, 0xCB1B, 0x2044, 0x7971, 0xAFCF, 0xF6FA, 0x1DA5, 0x4490,
+		0x1E68, 0x475D, 0xAC02, 0xF537, 0x2389, 0x7ABC, 0x91E3, 0xC8D6,
+		0x65AA, 0x3C9F, 0xD7C0, 0x8EF5, 0x584B, 0x017E, 0xEA21, 0xB314,
+	},
+	{16, 0x80B, true}: {
+		0x0000, 0x35A4, 0x6B48, 0x5EEC, 0xD690, 0xE334, 0xBDD8, 0x887C,
+		0x0D01, 0x38A5, 0x6649, 0x53ED, 0xDB91, 0xEE35, 0xB0D9, 0x857D,
+		0x1A02, 0x2FA6, 0x714A, 0x44EE, 0xCC92, 0xF936, 0xA7DA, 0x927E,
+		0x1703, 0x22A7, 0x7C4B, 0x49EF, 0xC193, 0xF437, 0xAADB, 0x9F7F,
+		0x3404, 0x01A0, 0x5F4C, 0x6AE8, 0xE294, 0xD730, 0x89DC, 0xBC78,
+		0x3905, 0x0CA1, 0x524D, 0x67E9, 0xEF95, 0xDA31, 0x84DD, 0xB179,
+		0x2E06, 0x1BA2, 0x454E, 0x70EA, 0xF896, 0xCD32, 0x93DE, 0xA67A,
+		0x2307, 0x16A3, 0x484F, 0x7DEB, 0xF597, 0xC033, 0x9EDF, 0xAB7B,
+		0x6808, 0x5DAC, 0x0340, 0x36E4, 0xBE98, 0x8B3C, 0xD5D0, 0xE074,
+		0x6509, 0x50AD, 0x0E41, 0x3BE5, 0xB399, 0x863D, 0xD8D1, 0xED75,
+		0x720A, 0x47AE, 0x1942, 0x2CE6, 0xA49A, 0x913E, 0xCFD2, 0xFA76,
+		0x7F0B, 0x4AAF, 0x1443, 0x21E7, 0xA99B, 0x9C3F, 0xC2D3, 0xF777,
+		0x5C0C, 0x69A8, 0x3744, 0x02E0, 0x8A9C, 0xBF38, 0xE1D4, 0xD470,
+		0x510D, 0x64A9, 0x3A45, 0x0FE1, 0x879D, 0xB239, 0xECD5, 0xD971,
+		0x460E, 0x73AA, 0x2D46, 0x18E2, 0x909E, 0xA53A, 0xFBD6, 0xCE72,
+		0x4B0F, 0x7EAB, 0x2047, 0x15E3, 0x9D9F, 0xA83B, 0xF6D7, 0xC373,
+		0xD010, 0xE5B4, 0xBB58, 0x8EFC, 0x0680, 0x3324, 0x6DC8, 0x586C,
+		0xDD11, 0xE8B5, 0xB659, 0x83FD, 0x0B81, 0x3E25, 0x60C9, 0x556D,
+		0xCA12, 0xFFB6, 0xA15A, 0x94FE, 0x1C82, 0x2926, 0x77CA, 0x426E,
+		0xC713, 0xF2B7, 0xAC5B, 0x99FF, 0x1183, 0x2427, 0x7ACB, 0x4F6F,
+		0xE414, 0xD1B0, 0x8F5C, 0xBAF8, 0x3284, 0x0720, 0x59CC, 0x6C68,
+		0xE915, 0xDCB1, 0x825D, 0xB7F9, 0x3F85, 0x0A21, 0x54CD, 0x6169,
+		0xFE16, 0xCBB2, 0x955E, 0xA0FA, 0x2886, 0x1D22, 0x43CE, 0x766A,
+		0xF317, 0xC6B3, 0x985F, 0xADFB, 0x2587, 0x1023, 0x4ECF, 0x7B6B,
+		0xB818, 0x8DBC, 0xD350, 0xE6F4, 0x6E88, 0x5B2C, 0x05C0, 0x3064,
+		0xB519, 0x80BD, 0xDE51, 0xEBF5, 0x6389, 0x562D, 0x08C1, 0x3D65,
+		0xA21A, 0x97BE, 0xC952, 0xFCF6, 0x748A, 0x412E, 0x1FC2, 0x2A66,
+		0xAF1B, 0x9ABF, 0xC453, 0xF1F7, 0x798B, 0x4C2F, 0x12C3, 0x2767,
+		0x8C1C, 0xB9B8, 0xE754, 0xD2F0, 0x5A8C, 0x6F28, 0x31C4, 0x0460,
+		0x811D, 0xB4B9, 0xEA55, 0xDFF1, 0x578D, 0x6229, 0x3CC5, 0x0961,
+		0x961E, 0xA3BA, 0xFD56, 0xC8F2, 0x408E, 0x752A, 0x2BC6, 0x1E62,
+		0x9B1F, 0xAEBB, 0xF057, 0xC5F3, 0x4D8F, 0x782B, 0x26C7, 0x1363,
+	},
+	{16, 0x755B, false}: {
+		0x0000, 0x755B, 0xEAB6, 0x9FED, 0xA037, 0xD56C, 0x4A81, 0x3FDA,
+		0x3535, 0x406E, 0xDF83, 0xAAD8, 0x9502, 0xE059, 0x7FB4, 0x0AEF,
+		0x6A6A, 0x1F31, 0x80DC, 0xF587, 0xCA5D, 0xBF06, 0x20EB, 0x55B0,
+		0x5F5F, 0x2A04, 0xB5E9, 0xC0B2, 0xFF68, 0x8A33, 0x15DE, 0x6085,
+		0xD4D4, 0xA18F, 0x3E62, 0x4B39, 0x74E3, 0x01B8, 0x9E55, 0xEB0E,
+		0xE1E1, 0x94BA, 0x0B57, 0x7E0C, 0x41D6, 0x348D, 0xAB60, 0xDE3B,
+		0xBEBE, 0xCBE5, 0x5408, 0x2153, 0x1E89, 0x6BD2, 0xF43F, 0x8164,
+		0x8B8B, 0xFED0, 0x613D, 0x1466, 0x2BBC, 0x5EE7, 0xC10A, 0xB451,
+		0xDCF3, 0xA9A8, 0x3645, 0x431E, 0x7CC4, 0x099F, 0x9672, 0xE329,
+		0xE9C6, 0x9C9D, 0x0370, 0x762B, 0x49F1, 0x3CAA, 0xA347, 0xD61C,
+		0xB699, 0xC3C2, 0x5C2F, 0x2974, 0x16AE, 0x63F5, 0xFC18, 0x8943,
+		0x83AC, 0xF6F7, 0x691A, 0x1C41, 0x239B, 0x56C0, 0xC92D, 0xBC76,
+		0x0827, 0x7D7C, 0xE291, 0x97CA, 0xA810, 0xDD4B, 0x42A6, 0x37FD,
+		0x3D12, 0x4849, 0xD7A4, 0xA2FF, 0x9D25, 0xE87E, 0x7793, 0x02C8,
+		0x624D, 0x1716, 0x88FB, 0xFDA0, 0xC27A, 0xB721, 0x28CC, 0x5D97,
+		0x5778, 0x2223, 0xBDCE, 0xC895, 0xF74F, 0x8214, 0x1DF9, 0x68A2,
+		0xCCBD, 0xB9E6, 0x260B, 0x5350, 0x6C8A, 0x19D1, 0x863C, 0xF367,
+		0xF988, 0x8CD3, 0x133E, 0x6665, 0x59BF, 0x2CE4, 0xB309, 0xC652,
+		0xA6D7, 0xD38C, 0x4C61, 0x393A, 0x06E0, 0x73BB, 0xEC56, 0x990D,
+		0x93E2, 0xE6B9, 0x7954, 0x0C0F, 0x33D5, 0x468E, 0xD963, 0xAC38,
+		0x1869, 0x6D32, 0xF2DF, 0x8784, 0xB85E, 0xCD05, 0x52E8, 0x27B3,
+		0x2D5C, 0x5807, 0xC7EA, 0xB2B1, 0x8D6B, 0xF830, 0x67DD, 0x1286,
+		0x7203, 0x0758, 0x98B5, 0xEDEE, 0xD234, 0xA76F, 0x3882, 0x4DD9,
+		0x4736, 0x326D, 0xAD80, 0xD8DB, 0xE701, 0x925A, 0x0DB7, 0x78EC,
+		0x104E, 0x6515, 0xFAF8, 0x8FA3, 0xB079, 0xC522, 0x5ACF, 0x2F94,
+		0x257B, 0x5020, 0xCFCD, 0xBA96, 0x854C, 0xF017, 0x6FFA, 0x1AA1,
+		0x7A24, 0x0F7F, 0x9092, 0xE5C9, 0xDA13, 0xAF48, 0x30A5, 0x45FE,
+		0x4F11, 0x3A4A, 0xA5A7, 0xD0FC, 0xEF26, 0x9A7D, 0x0590, 0x70CB,
+		0xC49A, 0xB1C1, 0x2E2C, 0x5B77, 0x64AD, 0x11F6, 0x8E1B, 0xFB40,
+		0xF1AF, 0x84F4, 0x1B19, 0x6E42, 0x5198, 0x24C3, 0xBB2E, 0xCE75,
+		0xAEF0, 0xDBAB, 0x4446, 0x311D, 0x0EC7, 0x7B9C, 0xE471, 0x912A,
+		0x9BC5, 0xEE9E, 0x7173, 0x0428, 0x3BF2, 0x4EA9, 0xD144, 0xA41F,
+	},
+	{16, 0x1DCF, false}: {
+		0x0000, 0x1DCF, 0x3B9E, 0x2651, 0x773C, 0x6AF3, 0x4CA2, 0x516D,
+		0xEE78, 0xF3B7, 0xD5E6, 0xC829, 0x9944, 0x848B, 0xA2DA, 0xBF15,
+		0xC13F, 0xDCF0, 0xFAA1, 0xE76E, 0xB603, 0xABCC, 0x8D9D, 0x9052,
+		0x2F47, 0x3288, 0x14D9, 0x0916, 0x587B, 0x45B4, 0x63E5, 0x7E2A,
+		0x9FB1, 0x827E, 0xA42F, 0xB9E0, 0xE88D, 0xF542, 0xD313, 0xCEDC,
+		0x71C9, 0x6C06, 0x4A57, 0x5798, 0x06F5, 0x1B3A, 0x3D6B, 0x20A4,
+		0x5E8E, 0x4341, 0x6510, 0x78DF, 0x29B2, 0x347D, 0x122C, 0x0FE3,
+		0xB0F6, 0xAD39, 0x8B68, 0x96A7, 0xC7CA, 0xDA05, 0xFC54, 0xE19B,
+		0x22AD, 0x3F62, 0x1933, 0x04FC, 0x5591, 0x485E, 0x6E0F, 0x73C0,
+		0xCCD5, 0xD11A, 0xF74B, 0xEA84, 0xBBE9, 0xA626, 0x8077, 0x9DB8,
+		0xE392, 0xFE5D, 0xD80C, 0xC5C3, 0x94AE, 0x8961, 0xAF30, 0xB2FF,
+		0x0DEA, 0x1025, 0x3674, 0x2BBB, 0x7AD6, 0x6719, 0x4148, 0x5C87,
+		0xBD1C, 0xA0D3, 0x8682, 0x9B4D, 0xCA20, 0xD7EF, 0xF1BE, 0xEC71,
+		0x5364, 0x4EAB, 0x68FA, 0x7535, 0x2458, 0x3997, 0x1FC6, 0x0209,
+		0x7C23, 0x61EC, 0x47BD, 0x5A72, 0x0B1F, 0x16D0, 0x3081, 0x2D4E,
+		0x925B, 0x8F94, 0xA9C5, 0xB40A, 0xE567, 0xF8A8, 0xDEF9, 0xC336,
+		0x455A, 0x5895, 0x7EC4, 0x630B, 0x3266, 0x2FA9, 0x09F8, 0x1437,
+		0xAB22, 0xB6ED, 0x90BC, 0x8D73, 0xDC1E, 0xC1D1, 0xE780, 0xFA4F,
+		0x8465, 0x99AA, 0xBFFB, 0xA234, 0xF359, 0xEE96, 0xC8C7, 0xD508,
+		0x6A1D, 0x77D2, 0x5183, 0x4C4C, 0x1D21, 0x00EE, 0x26BF, 0x3B70,
+		0xDAEB, 0xC724, 0xE175, 0xFCBA, 0xADD7, 0xB018, 0x9649, 0x8B86,
+		0x3493, 0x295C, 0x0F0D, 0x12C2, 0x43AF, 0x5E60, 0x7831, 0x65FE,
+		0x1BD4, 0x061B, 0x204A, 0x3D85, 0x6CE8, 0x7127, 0x5776, 0x4AB9,
+		0xF5AC, 0xE863, 0xCE32, 0xD3FD, 0x8290, 0x9F5F, 0xB90E, 0xA4C1,
+		0x67F7, 0x7A38, 0x5C69, 0x41A6, 0x10CB, 0x0D04, 0x2B55, 0x369A,
+		0x898F, 0x9440, 0xB211, 0xAFDE, 0xFEB3, 0xE37C, 0xC52D, 0xD8E2,
+		0xA6C8, 0xBB07, 0x9D56, 0x8099, 0xD1F4, 0xCC3B, 0xEA6A, 0xF7A5,
+		0x48B0, 0x557F, 0x732E, 0x6EE1, 0x3F8C, 0x2243, 0x0412, 0x19DD,
+		0xF846, 0xE589, 0xC3D8, 0xDE17, 0x8F7A, 0x92B5, 0xB4E4, 0xA92B,
+		0x163E, 0x0BF1, 0x2DA0, 0x306F, 0x6102, 0x7CCD, 0x5A9C, 0x4753,
+		0x3979, 0x24B6, 0x02E7, 0x1F28, 0x4E45, 0x538A, 0x75DB, 0x6814,
+		0xD701, 0xCACE, 0xEC9F, 0xF150, 0xA03D, 0xBDF2, 0x9BA3, 0x866C,
+	},
+	{16, 0x8BB7, false}: {
+		0x0000, 0x8BB7, 0x9CD9, 0x176E, 0xB205, 0x39B2, 0x2EDC, 0xA56B,
+		0xEFBD, 0x640A, 0x7364, 0xF8D3, 0x5DB8, 0xD60F, 0xC161, 0x4AD6,
+		0x54CD, 0xDF7A, 0xC814, 0x43A3, 0xE6C8, 0x6D7F, 0x7A11, 0xF1A6,
+		0xBB70, 0x30C7, 0x27A9, 0xAC1E, 0x0975, 0x82C2, 0x95AC, 0x1E1B,
+		0xA99A, 0x222D, 0x3543, 0xBEF4, 0x1B9F, 0x9028, 0x8746, 0x0CF1,
+		0x4627, 0xCD90, 0xDAFE, 0x5149, 0xF422, 0x7F95, 0x68FB, 0xE34C,
+		0xFD57, 0x76E0, 0x618E, 0xEA39, 0x4F52, 0xC4E5, 0xD38B, 0x583C,
+		0x12EA, 0x995D, 0x8E33, 0x0584, 0xA0EF, 0x2B58, 0x3C36, 0xB781,
+		0xD883, 0x5334, 0x445A, 0xCFED, 0x6A86, 0xE131, 0xF65F, 0x7DE8,
+		0x373E, 0xBC89, 0xABE7, 0x2050, 0x853B, 0x0E8C, 0x19E2, 0x9255,
+		0x8C4E, 0x07F9, 0x1097, 0x9B20, 0x3E4B, 0xB5FC, 0xA292, 0x2925,
+		0x63F3, 0xE844, 0xFF2A, 0x749D, 0xD1F6, 0x5A41, 0x4D2F, 0xC698,
+		0x7119, 0xFAAE, 0xEDC0, 0x6677, 0xC31C, 0x48AB, 0x5FC5, 0xD472,
+		0x9EA4, 0x1513, 0x027D, 0x89CA, 0x2CA1, 0xA716, 0xB078, 0x3BCF,
+		0x25D4, 0xAE63, 0xB90D, 0x32BA, 0x97D1, 0x1C66, 0x0B08, 0x80BF,
+		0xCA69, 0x41DE, 0x56B0, 0xDD07, 0x786C, 0xF3DB, 0xE4B5, 0x6F02,
+		0x3AB1, 0xB106, 0xA668, 0x2DDF, 0x88B4, 0x0303, 0x146D, 0x9FDA,
+		0xD50C, 0x5EBB, 0x49D5, 0xC262, 0x6709, 0xECBE, 0xFBD0, 0x7067,
+		0x6E7C, 0xE5CB, 0xF2A5, 0x7912, 0xDC79, 0x57CE, 0x40A0, 0xCB17,
+		0x81C1, 0x0A76, 0x1D18, 0x96AF, 0x33C4, 0xB873, 0xAF1D, 0x24AA,
+		0x932B, 0x189C, 0x0FF2, 0x8445, 0x212E, 0xAA99, 0xBDF7, 0x3640,
+		0x7C96, 0xF721, 0xE04F, 0x6BF8, 0xCE93, 0x4524, 0x524A, 0xD9FD,
+		0xC7E6, 0x4C51, 0x5B3F, 0xD088, 0x75E3, 0xFE54, 0xE93A, 0x628D,
+		0x285B, 0xA3EC, 0xB482, 0x3F35, 0x9A5E, 0x11E9, 0x0687, 0x8D30,
+		0xE232, 0x6985, 0x7EEB, 0xF55C, 0x5037, 0xDB80, 0xCCEE, 0x4759,
+		0x0D8F, 0x8638, 0x9156, 0x1AE1, 0xBF8A, 0x343D, 0x2353, 0xA8E4,
+		0xB6FF, 0x3D48, 0x2A26, 0xA191, 0x04FA, 0x8F4D, 0x9823, 0x1394,
+		0x5942, 0xD2F5, 0xC59B, 0x4E2C, 0xEB47, 0x60F0, 0x779E, 0xFC29,
+		0x4BA8, 0xC01F, 0xD771, 0x5CC6, 0xF9AD, 0x721A, 0x6574, 0xEEC3,
+		0xA415, 0x2FA2, 0x38CC, 0xB37B, 0x1610, 0x9DA7, 0x8AC9, 0x017E,
+		0x1F65, 0x94D2, 0x83BC, 0x080B, 0xAD60, 0x26D7, 0x31B9, 0xBA0E,
+		0xF0D8, 0x7B6F, 0x6C01, 0xE7B6, 0x42DD, 0xC96A, 0xDE04, 0x55B3,
+	},
+	{16, 0xA097, false}: {
+		0x0000, 0xA097, 0xE1B9, 0x412E, 0x63E5, 0xC372, 0x825C, 0x22CB,
+		0xC7CA, 0x675D, 0x2673, 0x86E4, 0xA42F, 0x04B8, 0x4596, 0xE501,
+		0x2F03, 0x8F94, 0xCEBA, 0x6E2D, 0x4CE6, 0xEC71, 0xAD5F, 0x0DC8,
+		0xE8C9, 0x485E, 0x0970, 0xA9E7, 0x8B2C, 0x2BBB, 0x6A95, 0xCA02,
+		0x5E06, 0xFE91, 0xBFBF, 0x1F28, 0x3DE3, 0x9D74, 0xDC5A, 0x7CCD,
+		0x99CC, 0x395B, 0x7875, 0xD8E2, 0xFA29, 0x5ABE, 0x1B90, 0xBB07,
+		0x7105, 0xD192, 0x90BC, 0x302B, 0x12E0, 0xB277, 0xF359, 0x53CE,
+		0xB6CF, 0x1658, 0x5776, 0xF7E1, 0xD52A, 0x75BD, 0x3493, 0x9404,
+		0xBC0C, 0x1C9B, 0x5DB5, 0xFD22, 0xDFE9, 0x7F7E, 0x3E50, 0x9EC7,
+		0x7BC6, 0xDB51, 0x9A7F, 0x3AE8, 0x1823, 0xB8B4, 0xF99A, 0x590D,
+		0x930F, 0x3398, 0x72B6, 0xD221, 0xF0EA, 0x507D, 0x1153, 0xB1C4,
+		0x54C5, 0xF452, 0xB57C, 0x15EB, 0x3720, 0x97B7, 0xD699, 0x760E,
+		0xE20A, 0x429D, 0x03B3, 0xA324, 0x81EF, 0x2178, 0x6056, 0xC0C1,
+		0x25C0, 0x8557, 0xC479, 0x64EE, 0x4625, 0xE6B2, 0xA79C, 0x070B,
+		0xCD09, 0x6D9E, 0x2CB0, 0x8C27, 0xAEEC, 0x0E7B, 0x4F55, 0xEFC2,
+		0x0AC3, 0xAA54, 0xEB7A, 0x4BED, 0x6926, 0xC9B1, 0x889F, 0x2808,
+		0xD88F, 0x7818, 0x3936, 0x99A1, 0xBB6A, 0x1BFD, 0x5AD3, 0xFA44,
+		0x1F45, 0xBFD2, 0xFEFC, 0x5E6B, 0x7CA0, 0xDC37, 0x9D19, 0x3D8E,
+		0xF78C, 0x571B, 0x1635, 0xB6A2, 0x9469, 0x34FE, 0x75D0, 0xD547,
+		0x3046, 0x90D1, 0xD1FF, 0x7168, 0x53A3, 0xF334, 0xB21A, 0x128D,
+		0x8689, 0x261E, 0x6730, 0xC7A7, 0xE56C, 0x45FB, 0x04D5, 0xA442,
+		0x4143, 0xE1D4, 0xA0FA, 0x006D, 0x22A6, 0x8231, 0xC31F, 0x6388,
+		0xA98A, 0x091D, 0x4833, 0xE8A4, 0xCA6F, 0x6AF8, 0x2BD6, 0x8B41,
+		0x6E40, 0xCED7, 0x8FF9, 0x2F6E, 0x0DA5, 0xAD32, 0xEC1C, 0x4C8B,
+		0x6483, 0xC414, 0x853A, 0x25AD, 0x0766, 0xA7F1, 0xE6DF, 0x4648,
+		0xA349, 0x03DE, 0x42F0, 0xE267, 0xC0AC, 0x603B, 0x2115, 0x8182,
+		0x4B80, 0xEB17, 0xAA39, 0x0AAE, 0x2865, 0x88F2, 0xC9DC, 0x694B,
+		0x8C4A, 0x2CDD, 0x6DF3, 0xCD64, 0xEFAF, 0x4F38, 0x0E16, 0xAE81,
+		0x3A85, 0x9A12, 0xDB3C, 0x7BAB, 0x5960, 0xF9F7, 0xB8D9, 0x184E,
+		0xFD4F, 0x5DD8, 0x1CF6, 0xBC61, 0x9EAA, 0x3E3D, 0x7F13, 0xDF84,
+		0x1586, 0xB511, 0xF43F, 0x54A8, 0x7663, 0xD6F4, 0x97DA, 0x374D,
+		0xD24C, 0x72DB, 0x33F5, 0x9362, 0xB1A9, 0x113E, 0x5010, 0xF087,
+	},
+	{17, 0x1685B, false}: {
+		0x00000, 0x1685B, 0x1B8ED, 0x0D0B6, 0x01981, 0x171DA, 0x1A16C, 0x0C937,
+		0x03302, 0x15B59, 0x18BEF, 0x0E3B4, 0x02A83, 0x142D8, 0x1926E, 0x0FA35,
+		0x06604, 0x10E5F, 0x1DEE9, 0x0B6B2, 0x07F85, 0x117DE, 0x1C768, 0x0AF33,
+		0x05506, 0x13D5D, 0x1EDEB, 0x085B0, 0x04C87, 0x124DC, 0x1F46A, 0x09C31,
+		0x0CC08, 0x1A453, 0x174E5, 0x01CBE, 0x0D589, 0x1BDD2, 0x16D64, 0x0053F,
+		0x0FF0A, 0x19751, 0x147E7, 0x02FBC, 0x0E68B, 0x18ED0, 0x15E66, 0x0363D,
+		0x0AA0C, 0x1C257, 0x112E1, 0x07ABA, 0x0B38D, 0x1DBD6, 0x10B60, 0x0633B,
+		0x0990E, 0x1F155, 0x121E3, 0x049B8, 0x0808F, 0x1E8D4, 0x13862, 0x05039,
+		0x19810, 0x0F04B, 0x020FD, 0x148A6, 0x18191, 0x0E9CA, 0x0397C, 0x15127,
+		0x1AB12, 0x0C349, 0x013FF, 0x17BA4, 0x1B293, 0x0DAC8, 0x00A7E, 0x16225,
+		0x1FE14, 0x0964F, 0x046F9, 0x12EA2, 0x1E795, 0x08FCE, 0x05F78, 0x13723,
+		0x1CD16, 0x0A54D, 0x075FB, 0x11DA0, 0x1D497, 0x0BCCC, 0x06C7A, 0x10421,
+		0x15418, 0x03C43, 0x0ECF5, 0x184AE, 0x14D99, 0x025C2, 0x0F574, 0x19D2F,
+		0x1671A, 0x00F41, 0x0DFF7, 0x1B7AC, 0x17E9B, 0x016C0, 0x0C676, 0x1AE2D,
+		0x1321C, 0x05A47, 0x08AF1, 0x1E2AA, 0x12B9D, 0x043C6, 0x09370, 0x1FB2B,
+		0x1011E, 0x06945, 0x0B9F3, 0x1D1A8, 0x1189F, 0x070C4, 0x0A072, 0x1C829,
+		0x0587B, 0x13020, 0x1E096, 0x088CD, 0x041FA, 0x129A1, 0x1F917, 0x0914C,
+		0x06B79, 0x10322, 0x1D394, 0x0BBCF, 0x072F8, 0x11AA3, 0x1CA15, 0x0A24E,
+		0x03E7F, 0x15624, 0x18692, 0x0EEC9, 0x027FE, 0x14FA5, 0x19F13, 0x0F748,
+		0x00D7D, 0x16526, 0x1B590, 0x0DDCB, 0x014FC, 0x17CA7, 0x1AC11, 0x0C44A,
+		0x09473, 0x1FC28, 0x12C9E, 0x044C5, 0x08DF2, 0x1E5A9, 0x1351F, 0x05D44,
+		0x0A771, 0x1CF2A, 0x11F9C, 0x077C7, 0x0BEF0, 0x1D6AB, 0x1061D, 0x06E46,
+		0x0F277, 0x19A2C, 0x14A9A, 0x022C1, 0x0EBF6, 0x183AD, 0x1531B, 0x03B40,
+		0x0C175, 0x1A92E, 0x17998, 0x011C3, 0x0D8F4, 0x1B0AF, 0x16019, 0x00842,
+		0x1C06B, 0x0A830, 0x07886, 0x110DD, 0x1D9EA, 0x0B1B1, 0x06107, 0x1095C,
+		0x1F369, 0x09B32, 0x04B84, 0x123DF, 0x1EAE8, 0x082B3, 0x05205, 0x13A5E,
+		0x1A66F, 0x0CE34, 0x01E82, 0x176D9, 0x1BFEE, 0x0D7B5, 0x00703, 0x16F58,
+		0x1956D, 0x0FD36, 0x02D80, 0x145DB, 0x18CEC, 0x0E4B7, 0x03401, 0x15C5A,
+		0x10C63, 0x06438, 0x0B48E, 0x1DCD5, 0x115E2, 0x07DB9, 0x0AD0F, 0x1C554,
+		0x13F61, 0x0573A, 0x0878C, 0x1EFD7, 0x126E0, 0x04EBB, 0x09E0D, 0x1F656,
+		0x16A67, 0x0023C, 0x0D28A, 0x1BAD1, 0x173E6, 0x01BBD, 0x0CB0B, 0x1A350,
+		0x15965, 0x0313E, 0x0E188, 0x189D3, 0x140E4, 0x028BF, 0x0F809, 0x19052,
+	},
+	{21, 0x102899, false}: {
+		0x000000, 0x102899, 0x1079AB, 0x005132, 0x10DBCF, 0x00F356, 0x00A264, 0x108AFD,
+		0x119F07, 0x01B79E, 0x01E6AC, 0x11CE35, 0x0144C8, 0x116C51, 0x113D63, 0x0115FA,
+		0x131697, 0x033E0E, 0x036F3C, 0x1347A5, 0x03CD58, 0x13E5C1, 0x13B4F3, 0x039C6A,
+		0x028990, 0x12A109, 0x12F03B, 0x02D8A2, 0x12525F, 0x027AC6, 0x022BF4, 0x12036D,
+		0x1605B7, 0x062D2E, 0x067C1C, 0x165485, 0x06DE78, 0x16F6E1, 0x16A7D3, 0x068F4A,
+		0x079AB0, 0x17B229, 0x17E31B, 0x07CB82, 0x17417F, 0x0769E6, 0x0738D4, 0x17104D,
+		0x051320, 0x153BB9, 0x156A8B, 0x054212, 0x15C8EF, 0x05E076, 0x05B144, 0x1599DD,
+		0x148C27, 0x04A4BE, 0x04F58C, 0x14DD15, 0x0457E8, 0x147F71, 0x142E43, 0x0406DA,
+		0x1C23F7, 0x0C0B6E, 0x0C5A5C, 0x1C72C5, 0x0CF838, 0x1CD0A1, 0x1C8193, 0x0CA90A,
+		0x0DBCF0, 0x1D9469, 0x1DC55B, 0x0DEDC2, 0x1D673F, 0x0D4FA6, 0x0D1E94, 0x1D360D,
+		0x0F3560, 0x1F1DF9, 0x1F4CCB, 0x0F6452, 0x1FEEAF, 0x0FC636, 0x0F9704, 0x1FBF9D,
+		0x1EAA67, 0x0E82FE, 0x0ED3CC, 0x1EFB55, 0x0E71A8, 0x1E5931, 0x1E0803, 0x0E209A,
+		0x0A2640, 0x1A0ED9, 0x1A5FEB, 0x0A7772, 0x1AFD8F, 0x0AD516, 0x0A8424, 0x1AACBD,
+		0x1BB947, 0x0B91DE, 0x0BC0EC, 0x1BE875, 0x0B6288, 0x1B4A11, 0x1B1B23, 0x0B33BA,
+		0x1930D7, 0x09184E, 0x09497C, 0x1961E5, 0x09EB18, 0x19C381, 0x1992B3, 0x09BA2A,
+		0x08AFD0, 0x188749, 0x18D67B, 0x08FEE2, 0x18741F, 0x085C86, 0x080DB4, 0x18252D,
+		0x086F77, 0x1847EE, 0x1816DC, 0x083E45, 0x18B4B8, 0x089C21, 0x08CD13, 0x18E58A,
+		0x19F070, 0x09D8E9, 0x0989DB, 0x19A142, 0x092BBF, 0x190326, 0x195214, 0x097A8D,
+		0x1B79E0, 0x0B5179, 0x0B004B, 0x1B28D2, 0x0BA22F, 0x1B8AB6, 0x1BDB84, 0x0BF31D,
+		0x0AE6E7, 0x1ACE7E, 0x1A9F4C, 0x0AB7D5, 0x1A3D28, 0x0A15B1, 0x0A4483, 0x1A6C1A,
+		0x1E6AC0, 0x0E4259, 0x0E136B, 0x1E3BF2, 0x0EB10F, 0x1E9996, 0x1EC8A4, 0x0EE03D,
+		0x0FF5C7, 0x1FDD5E, 0x1F8C6C, 0x0FA4F5, 0x1F2E08, 0x0F0691, 0x0F57A3, 0x1F7F3A,
+		0x0D7C57, 0x1D54CE, 0x1D05FC, 0x0D2D65, 0x1DA798, 0x0D8F01, 0x0DDE33, 0x1DF6AA,
+		0x1CE350, 0x0CCBC9, 0x0C9AFB, 0x1CB262, 0x0C389F, 0x1C1006, 0x1C4134, 0x0C69AD,
+		0x144C80, 0x046419, 0x04352B, 0x141DB2, 0x04974F, 0x14BFD6, 0x14EEE4, 0x04C67D,
+		0x05D387, 0x15FB1E, 0x15AA2C, 0x0582B5, 0x150848, 0x0520D1, 0x0571E3, 0x15597A,
+		0x075A17, 0x17728E, 0x1723BC, 0x070B25, 0x1781D8, 0x07A941, 0x07F873, 0x17D0EA,
+		0x16C510, 0x06ED89, 0x06BCBB, 0x169422, 0x061EDF, 0x163646, 0x166774, 0x064FED,
+		0x024937, 0x1261AE, 0x12309C, 0x021805, 0x1292F8, 0x02BA61, 0x02EB53, 0x12C3CA,
+		0x13D630, 0x03FEA9, 0x03AF9B, 0x138702, 0x030DFF, 0x132566, 0x137454, 0x035CCD,
+		0x115FA0, 0x017739, 0x01260B, 0x110E92, 0x01846F, 0x11ACF6, 0x11FDC4, 0x01D55D,
+		0x00C0A7, 0x10E83E, 0x10B90C, 0x009195, 0x101B68, 0x0033F1, 0x0062C3, 0x104A5A,
+	},
+	{24, 0x65B, true}: {
+		0x000000, 0x01B4C0, 0x036980, 0x02DD40, 0x06D300, 0x0767C0, 0x05BA80, 0x040E40,
+		0x0DA600, 0x0C12C0, 0x0ECF80, 0x0F7B40, 0x0B7500, 0x0AC1C0, 0x081C80, 0x09A840,
+		0x1B4C00, 0x1AF8C0, 0x182580, 0x199140, 0x1D9F00, 0x1C2BC0, 0x1EF680, 0x1F4240,
+		0x16EA00, 0x175EC0, 0x158380, 0x143740, 0x103900, 0x118DC0, 0x135080, 0x12E440,
+		0x369800, 0x372CC0, 0x35F180, 0x344540, 0x304B00, 0x31FFC0, 0x332280, 0x329640,
+		0x3B3E00, 0x3A8AC0, 0x385780, 0x39E340, 0x3DED00, 0x3C59C0, 0x3E8480, 0x3F3040,
+		0x2DD400, 0x2C60C0, 0x2EBD80, 0x2F0940, 0x2B0700, 0x2AB3C0, 0x286E80, 0x29DA40,
+		0x207200, 0x21C6C0, 0x231B80, 0x22AF40, 0x26A100, 0x2715C0, 0x25C880, 0x247C40,
+		0x6D3000, 0x6C84C0, 0x6E5980, 0x6FED40, 0x6BE300, 0x6A57C0, 0x688A80, 0x693E40,
+		0x609600, 0x6122C0, 0x63FF80, 0x624B40, 0x664500, 0x67F1C0, 0x652C80, 0x649840,
+		0x767C00, 0x77C8C0, 0x751580, 0x74A140, 0x70AF00, 0x711BC0, 0x73C680, 0x727240,
+		0x7BDA00, 0x7A6EC0, 0x78B380, 0x790740, 0x7D0900, 0x7CBDC0, 0x7E6080, 0x7FD440,
+		0x5BA800, 0x5A1CC0, 0x58C180, 0x597540, 0x5D7B00, 0x5CCFC0, 0x5E1280, 0x5FA640,
+		0x560E00, 0x57BAC0, 0x556780, 0x54D340, 0x50DD00, 0x5169C0, 0x53B480, 0x520040,
+		0x40E400, 0x4150C0, 0x438D80, 0x423940, 0x463700, 0x4783C0, 0x455E80, 0x44EA40,
+		0x4D4200, 0x4CF6C0, 0x4E2B80, 0x4F9F40, 0x4B9100, 0x4A25C0, 0x48F880, 0x494C40,
+		0xDA6000, 0xDBD4C0, 0xD90980, 0xD8BD40, 0xDCB300, 0xDD07C0, 0xDFDA80, 0xDE6E40,
+		0xD7C600, 0xD672C0, 0xD4AF80, 0xD51B40, 0xD11500, 0xD0A1C0, 0xD27C80, 0xD3C840,
+		0xC12C00, 0xC098C0, 0xC24580, 0xC3F140, 0xC7FF00, 0xC64BC0, 0xC49680, 0xC52240,
+		0xCC8A00, 0xCD3EC0, 0xCFE380, 0xCE5740, 0xCA5900, 0xCBEDC0, 0xC93080, 0xC88440,
+		0xECF800, 0xED4CC0, 0xEF9180, 0xEE2540, 0xEA2B00, 0xEB9FC0, 0xE94280, 0xE8F640,
+		0xE15E00, 0xE0EAC0, 0xE23780, 0xE38340, 0xE78D00, 0xE639C0, 0xE4E480, 0xE55040,
+		0xF7B400, 0xF600C0, 0xF4DD80, 0xF56940, 0xF16700, 0xF0D3C0, 0xF20E80, 0xF3BA40,
+		0xFA1200, 0xFBA6C0, 0xF97B80, 0xF8CF40, 0xFCC100, 0xFD75C0, 0xFFA880, 0xFE1C40,
+		0xB75000, 0xB6E4C0, 0xB43980, 0xB58D40, 0xB18300, 0xB037C0, 0xB2EA80, 0xB35E40,
+		0xBAF600, 0xBB42C0, 0xB99F80, 0xB82B40, 0xBC2500, 0xBD91C0, 0xBF4C80, 0xBEF840,
+		0xAC1C00, 0xADA8C0, 0xAF7580, 0xAEC140, 0xAACF00, 0xAB7BC0, 0xA9A680, 0xA81240,
+		0xA1BA00, 0xA00EC0, 0xA2D380, 0xA36740, 0xA76900, 0xA6DDC0, 0xA40080, 0xA5B440,
+		0x81C800, 0x807CC0, 0x82A180, 0x831540, 0x871B00, 0x86AFC0, 0x847280, 0x85C640,
+		0x8C6E00, 0x8DDAC0, 0x8F0780, 0x8EB340, 0x8ABD00, 0x8B09C0, 0x89D480, 0x886040,
+		0x9A8400, 0x9B30C0, 0x99ED80, 0x985940, 0x9C5700, 0x9DE3C0, 0x9F3E80, 0x9E8A40,
+		0x972200, 0x9696C0, 0x944B80, 0x95FF40, 0x91F100, 0x9045C0, 0x929880, 0x932C40,
+	},
+	{24, 0x5D6DCB, false}: {
+		0x000000, 0x5D6DCB, 0xBADB96, 0xE7B65D, 0x28DAE7, 0x75B72C, 0x920171, 0xCF6CBA,
+		0x51B5CE, 0x0CD805, 0xEB6E58, 0xB60393, 0x796F29, 0x2402E2, 0xC3B4BF, 0x9ED974,
+		0xA36B9C, 0xFE0657, 0x19B00A, 0x44DDC1, 0x8BB17B, 0xD6DCB0, 0x316AED, 0x6C0726,
+		0xF2DE52, 0xAFB399, 0x4805C4, 0x15680F, 0xDA04B5, 0x87697E, 0x60DF23, 0x3DB2E8,
+		0x1BBAF3, 0x46D738, 0xA16165, 0xFC0CAE, 0x336014, 0x6E0DDF, 0x89BB82, 0xD4D649,
+		0x4A0F3D, 0x1762F6, 0xF0D4AB, 0xADB960, 0x62D5DA, 0x3FB811, 0xD80E4C, 0x856387,
+		0xB8D16F, 0xE5BCA4, 0x020AF9, 0x5F6732, 0x900B88, 0xCD6643, 0x2AD01E, 0x77BDD5,
+		0xE964A1, 0xB4096A, 0x53BF37, 0x0ED2FC, 0xC1BE46, 0x9CD38D, 0x7B65D0, 0x26081B,
+		0x3775E6, 0x6A182D, 0x8DAE70, 0xD0C3BB, 0x1FAF01, 0x42C2CA, 0xA57497, 0xF8195C,
+		0x66C028, 0x3BADE3, 0xDC1BBE, 0x817675, 0x4E1ACF, 0x137704, 0xF4C159, 0xA9AC92,
+		0x941E7A, 0xC973B1, 0x2EC5EC, 0x73A827, 0xBCC49D, 0xE1A956, 0x061F0B, 0x5B72C0,
+		0xC5ABB4, 0x98C67F, 0x7F7022, 0x221DE9, 0xED7153, 0xB01C98, 0x57AAC5, 0x0AC70E,
+		0x2CCF15, 0x71A2DE, 0x961483, 0xCB7948, 0x0415F2, 0x597839, 0xBECE64, 0xE3A3AF,
+		0x7D7ADB, 0x201710, 0xC7A14D, 0x9ACC86, 0x55A03C, 0x08CDF7, 0xEF7BAA, 0xB21661,
+		0x8FA489, 0xD2C942, 0x357F1F, 0x6812D4, 0xA77E6E, 0xFA13A5, 0x1DA5F8, 0x40C833,
+		0xDE1147, 0x837C8C, 0x64CAD1, 0x39A71A, 0xF6CBA0, 0xABA66B, 0x4C1036, 0x117DFD,
+		0x6EEBCC, 0x338607, 0xD4305A, 0x895D91, 0x46312B, 0x1B5CE0, 0xFCEABD, 0xA18776,
+		0x3F5E02, 0x6233C9, 0x858594, 0xD8E85F, 0x1784E5, 0x4AE92E, 0xAD5F73, 0xF032B8,
+		0xCD8050, 0x90ED9B, 0x775BC6, 0x2A360D, 0xE55AB7, 0xB8377C, 0x5F8121, 0x02ECEA,
+		0x9C359E, 0xC15855, 0x26EE08, 0x7B83C3, 0xB4EF79, 0xE982B2, 0x0E34EF, 0x535924,
+		0x75513F, 0x283CF4, 0xCF8AA9, 0x92E762, 0x5D8BD8, 0x00E613, 0xE7504E, 0xBA3D85,
+		0x24E4F1, 0x79893A, 0x9E3F67, 0xC352AC, 0x0C3E16, 0x5153DD, 0xB6E580, 0xEB884B,
+		0xD63AA3, 0x8B5768, 0x6CE135, 0x318CFE, 0xFEE044, 0xA38D8F, 0x443BD2, 0x195619,
+		0x878F6D, 0xDAE2A6, 0x3D54FB, 0x603930, 0xAF558A, 0xF23841, 0x158E1C, 0x48E3D7,
+		0x599E2A, 0x04F3E1, 0xE345BC, 0xBE2877, 0x7144CD, 0x2C2906, 0xCB9F5B, 0x96F290,
+		0x082BE4, 0x55462F, 0xB2F072, 0xEF9DB9, 0x20F103, 0x7D9CC8, 0x9A2A95, 0xC7475E,
+		0xFAF5B6, 0xA7987D, 0x402E20, 0x1D43EB, 0xD22F51, 0x8F429A, 0x68F4C7, 0x35990C,
+		0xAB4078, 0xF62DB3, 0x119BEE, 0x4CF625, 0x839A9F, 0xDEF754, 0x394109, 0x642CC2,
+		0x4224D9, 0x1F4912, 0xF8FF4F, 0xA59284, 0x6AFE3E, 0x3793F5, 0xD025A8, 0x8D4863,
+		0x139117, 0x4EFCDC, 0xA94A81, 0xF4274A, 0x3B4BF0, 0x66263B, 0x819066, 0xDCFDAD,
+		0xE14F45, 0xBC228E, 0x5B94D3, 0x06F918, 0xC995A2, 0x94F869, 0x734E34, 0x2E23FF,
+		0xB0FA8B, 0xED9740, 0x0A211D, 0x574CD6, 0x98206C, 0xC54DA7, 0x22FBFA, 0x7F9631,
+	},
+	{24, 0x328B63, false}: {
+		0x000000, 0x328B63, 0x6516C6, 0x579DA5, 0xCA2D8C, 0xF8A6EF, 0xAF3B4A, 0x9DB029,
+		0xA6D07B, 0x945B18, 0xC3C6BD, 0xF14DDE, 0x6CFDF7, 0x5E7694, 0x09EB31, 0x3B6052,
+		0x7F2B95, 0x4DA0F6, 0x1A3D53, 0x28B630, 0xB50619, 0x878D7A, 0xD010DF, 0xE29BBC,
+		0xD9FBEE, 0xEB708D, 0xBCED28, 0x8E664B, 0x13D662, 0x215D01, 0x76C0A4, 0x444BC7,
+		0xFE572A, 0xCCDC49, 0x9B41EC, 0xA9CA8F, 0x347AA6, 0x06F1C5, 0x516C60, 0x63E703,
+		0x588751, 0x6A0C32, 0x3D9197, 0x0F1AF4, 0x92AADD, 0xA021BE, 0xF7BC1B, 0xC53778,
+		0x817CBF, 0xB3F7DC, 0xE46A79, 0xD6E11A, 0x4B5133, 0x79DA50, 0x2E47F5, 0x1CCC96,
+		0x27ACC4, 0x1527A7, 0x42BA02, 0x703161, 0xED8148, 0xDF0A2B, 0x88978E, 0xBA1CED,
+		0xCE2537, 0xFCAE54, 0xAB33F1, 0x99B892, 0x0408BB, 0x3683D8, 0x611E7D, 0x53951E,
+		0x68F54C, 0x5A7E2F, 0x0DE38A, 0x3F68E9, 0xA2D8C0, 0x9053A3, 0xC7CE06, 0xF54565,
+		0xB10EA2, 0x8385C1, 0xD41864, 0xE69307, 0x7B232E, 0x49A84D, 0x1E35E8, 0x2CBE8B,
+		0x17DED9, 0x2555BA, 0x72C81F, 0x40437C, 0xDDF355, 0xEF7836, 0xB8E593, 0x8A6EF0,
+		0x30721D, 0x02F97E, 0x5564DB, 0x67EFB8, 0xFA5F91, 0xC8D4F2, 0x9F4957, 0xADC234,
+		0x96A266, 0xA42905, 0xF3B4A0, 0xC13FC3, 0x5C8FEA, 0x6E0489, 0x39992C, 0x0B124F,
+		0x4F5988, 0x7DD2EB, 0x2A4F4E, 0x18C42D, 0x857404, 0xB7FF67, 0xE062C2, 0xD2E9A1,
+		0xE989F3, 0xDB0290, 0x8C9F35, 0xBE1456, 0x23A47F, 0x112F1C, 0x46B2B9, 0x7439DA,
+		0xAEC10D, 0x9C4A6E, 0xCBD7CB, 0xF95CA8, 0x64EC81, 0x5667E2, 0x01FA47, 0x337124,
+		0x081176, 0x3A9A15, 0x6D07B0, 0x5F8CD3, 0xC23CFA, 0xF0B799, 0xA72A3C, 0x95A15F,
+		0xD1EA98, 0xE361FB, 0xB4FC5E, 0x86773D, 0x1BC714, 0x294C77, 0x7ED1D2, 0x4C5AB1,
+		0x773AE3, 0x45B180, 0x122C25, 0x20A746, 0xBD176F, 0x8F9C0C, 0xD801A9, 0xEA8ACA,
+		0x509627, 0x621D44, 0x3580E1, 0x070B82, 0x9ABBAB, 0xA830C8, 0xFFAD6D, 0xCD260E,
+		0xF6465C, 0xC4CD3F, 0x93509A, 0xA1DBF9, 0x3C6BD0, 0x0EE0B3, 0x597D16, 0x6BF675,
+		0x2FBDB2, 0x1D36D1, 0x4AAB74, 0x782017, 0xE5903E, 0xD71B5D, 0x8086F8, 0xB20D9B,
+		0x896DC9, 0xBBE6AA, 0xEC7B0F, 0xDEF06C, 0x434045, 0x71CB26, 0x265683, 0x14DDE0,
+		0x60E43A, 0x526F59, 0x05F2FC, 0x37799F, 0xAAC9B6, 0x9842D5, 0xCFDF70, 0xFD5413,
+		0xC63441, 0xF4BF22, 0xA32287, 0x91A9E4, 0x0C19CD, 0x3E92AE, 0x690F0B, 0x5B8468,
+		0x1FCFAF, 0x2D44CC, 0x7AD969, 0x48520A, 0xD5E223, 0xE76940, 0xB0F4E5, 0x827F86,
+		0xB91FD4, 0x8B94B7, 0xDC0912, 0xEE8271, 0x733258, 0x41B93B, 0x16249E, 0x24AFFD,
+		0x9EB310, 0xAC3873, 0xFBA5D6, 0xC92EB5, 0x549E9C, 0x6615FF, 0x31885A, 0x030339,
+		0x38636B, 0x0AE808, 0x5D75AD, 0x6FFECE, 0xF24EE7, 0xC0C584, 0x975821, 0xA5D342,
+		0xE19885, 0xD313E6, 0x848E43, 0xB60520, 0x2BB509, 0x193E6A, 0x4EA3CF, 0x7C28AC,
+		0x4748FE, 0x75C39D, 0x225E38, 0x10D55B, 0x8D6572, 0xBFEE11, 0xE873B4, 0xDAF8D7,
+	},
+	{24, 0x864CFB, false}: {
+		0x000000, 0x864CFB, 0x8AD50D, 0x0C99F6, 0x93E6E1, 0x15AA1A, 0x1933EC, 0x9F7F17,
+		0xA18139, 0x27CDC2, 0x2B5434, 0xAD18CF, 0x3267D8, 0xB42B23, 0xB8B2D5, 0x3EFE2E,
+		0xC54E89, 0x430272, 0x4F9B84, 0xC9D77F, 0x56A868, 0xD0E493, 0xDC7D65, 0x5A319E,
+		0x64CFB0, 0xE2834B, 0xEE1ABD, 0x685646, 0xF72951, 0x7165AA, 0x7DFC5C, 0xFBB0A7,
+		0x0CD1E9, 0x8A9D12, 0x8604E4, 0x00481F, 0x9F3708, 0x197BF3, 0x15E205, 0x93AEFE,
+		0xAD50D0, 0x2B1C2B, 0x2785DD, 0xA1C926, 0x3EB631, 0xB8FACA, 0xB4633C, 0x322FC7,
+		0xC99F60, 0x4FD39B, 0x434A6D, 0xC50696, 0x5A7981, 0xDC357A, 0xD0AC8C, 0x56E077,
+		0x681E59, 0xEE52A2, 0xE2CB54, 0x6487AF, 0xFBF8B8, 0x7DB443, 0x712DB5, 0xF7614E,
+		0x19A3D2, 0x9FEF29, 0x9376DF, 0x153A24, 0x8A4533, 0x0C09C8, 0x00903E, 0x86DCC5,
+		0xB822EB, 0x3E6E10, 0x32F7E6, 0xB4BB1D, 0x2BC40A, 0xAD88F1, 0xA11107, 0x275DFC,
+		0xDCED5B, 0x5AA1A0, 0x563856, 0xD074AD, 0x4F0BBA, 0xC94741, 0xC5DEB7, 0x43924C,
+		0x7D6C62, 0xFB2099, 0xF7B96F, 0x71F594, 0xEE8A83, 0x68C678, 0x645F8E, 0xE21375,
+		0x15723B, 0x933EC0, 0x9FA736, 0x19EBCD, 0x8694DA, 0x00D821, 0x0C41D7, 0x8A0D2C,
+		0xB4F302, 0x32BFF9, 0x3E260F, 0xB86AF4, 0x2715E3, 0xA15918, 0xADC0EE, 0x2B8C15,
+		0xD03CB2, 0x567049, 0x5AE9BF, 0xDCA544, 0x43DA53, 0xC596A8, 0xC90F5E, 0x4F43A5,
+		0x71BD8B, 0xF7F170, 0xFB6886, 0x7D247D, 0xE25B6A, 0x641791, 0x688E67, 0xEEC29C,
+		0x3347A4, 0xB50B5F, 0xB992A9, 0x3FDE52, 0xA0A145, 0x26EDBE, 0x2A7448, 0xAC38B3,
+		0x92C69D, 0x148A66, 0x181390, 0x9E5F6B, 0x01207C, 0x876C87, 0x8BF571, 0x0DB98A,
+		0xF6092D, 0x7045D6, 0x7CDC20, 0xFA90DB, 0x65EFCC, 0xE3A337, 0xEF3AC1, 0x69763A,
+		0x578814, 0xD1C4EF, 0xDD5D19, 0x5B11E2, 0xC46EF5, 0x42220E, 0x4EBBF8, 0xC8F703,
+		0x3F964D, 0xB9DAB6, 0xB54340, 0x330FBB, 0xAC70AC, 0x2A3C57, 0x26A5A1, 0xA0E95A,
+		0x9E1774, 0x185B8F, 0x14C279, 0x928E82, 0x0DF195, 0x8BBD6E, 0x872498, 0x016863,
+		0xFAD8C4, 0x7C943F, 0x700DC9, 0xF64132, 0x693E25, 0xEF72DE, 0xE3EB28, 0x65A7D3,
+		0x5B59FD, 0xDD1506, 0xD18CF0, 0x57C00B, 0xC8BF1C, 0x4EF3E7, 0x426A11, 0xC426EA,
+		0x2AE476, 0xACA88D, 0xA0317B, 0x267D80, 0xB90297, 0x3F4E6C, 0x33D79A, 0xB59B61,
+		0x8B654F, 0x0D29B4, 0x01B042, 0x87FCB9, 0x1883AE, 0x9ECF55, 0x9256A3, 0x141A58,
+		0xEFAAFF, 0x69E604, 0x657FF2, 0xE33309, 0x7C4C1E, 0xFA00E5, 0xF69913, 0x70D5E8,
+		0x4E2BC6, 0xC8673D, 0xC4FECB, 0x42B230, 0xDDCD27, 0x5B81DC, 0x57182A, 0xD154D1,
+		0x26359F, 0xA07964, 0xACE092, 0x2AAC69, 0xB5D37E, 0x339F85, 0x3F0673, 0xB94A88,
+		0x87B4A6, 0x01F85D, 0x0D61AB, 0x8B2D50, 0x145247, 0x921EBC, 0x9E874A, 0x18CBB1,
+		0xE37B16, 0x6537ED, 0x69AE1B, 0xEFE2E0, 0x709DF7, 0xF6D10C, 0xFA48FA, 0x7C0401,
+		0x42FA2F, 0xC4B6D4, 0xC82F22, 0x4E63D9, 0xD11CCE, 0x575035, 0x5BC9C3, 0xDD8538,
+	},
+	{24, 0x800063, false}: {
+		0x000000, 0x800063, 0x8000A5, 0x0000C6, 0x800129, 0x00014A, 0x00018C, 0x8001EF,
+		0x800231, 0x000252, 0x000294, 0x8002F7, 0x000318, 0x80037B, 0x8003BD, 0x0003DE,
+		0x800401, 0x000462, 0x0004A4, 0x8004C7, 0x000528, 0x80054B, 0x80058D, 0x0005EE,
+		0x000630, 0x800653, 0x800695, 0x0006F6, 0x800719, 0x00077A, 0x0007BC, 0x8007DF,
+		0x800861, 0x000802, 0x0008C4, 0x8008A7, 0x000948, 0x80092B, 0x8009ED, 0x00098E,
+		0x000A50, 0x800A33, 0x800AF5, 0x000A96, 0x800B79, 0x000B1A, 0x000BDC, 0x800BBF,
+		0x000C60, 0x800C03, 0x800CC5, 0x000CA6, 0x800D49, 0x000D2A, 0x000DEC, 0x800D8F,
+		0x800E51, 0x000E32, 0x000EF4, 0x800E97, 0x000F78, 0x800F1B, 0x800FDD, 0x000FBE,
+		0x8010A1, 0x0010C2, 0x001004, 0x801067, 0x001188, 0x8011EB, 0x80112D, 0x00114E,
+		0x001290, 0x8012F3, 0x801235, 0x001256, 0x8013B9, 0x0013DA, 0x00131C, 0x80137F,
+		0x0014A0, 0x8014C3, 0x801405, 0x001466, 0x801589, 0x0015EA, 0x00152C, 0x80154F,
+		0x801691, 0x0016F2, 0x001634, 0x801657, 0x0017B8, 0x8017DB, 0x80171D, 0x00177E,
+		0x0018C0, 0x8018A3, 0x801865, 0x001806, 0x8019E9, 0x00198A, 0x00194C, 0x80192F,
+		0x801AF1, 0x001A92, 0x001A54, 0x801A37, 0x001BD8, 0x801BBB, 0x801B7D, 0x001B1E,
+		0x801CC1, 0x001CA2, 0x001C64, 0x801C07, 0x001DE8, 0x801D8B, 0x801D4D, 0x001D2E,
+		0x001EF0, 0x801E93, 0x801E55, 0x001E36, 0x801FD9, 0x001FBA, 0x001F7C, 0x801F1F,
+		0x802121, 0x002142, 0x002184, 0x8021E7, 0x002008, 0x80206B, 0x8020AD, 0x0020CE,
+		0x002310, 0x802373, 0x8023B5, 0x0023D6, 0x802239, 0x00225A, 0x00229C, 0x8022FF,
+		0x002520, 0x802543, 0x802585, 0x0025E6, 0x802409, 0x00246A, 0x0024AC, 0x8024CF,
+		0x802711, 0x002772, 0x0027B4, 0x8027D7, 0x002638, 0x80265B, 0x80269D, 0x0026FE,
+		0x002940, 0x802923, 0x8029E5, 0x002986, 0x802869, 0x00280A, 0x0028CC, 0x8028AF,
+		0x802B71, 0x002B12, 0x002BD4, 0x802BB7, 0x002A58, 0x802A3B, 0x802AFD, 0x002A9E,
+		0x802D41, 0x002D22, 0x002DE4, 0x802D87, 0x002C68, 0x802C0B, 0x802CCD, 0x002CAE,
+		0x002F70, 0x802F13, 0x802FD5, 0x002FB6, 0x802E59, 0x002E3A, 0x002EFC, 0x802E9F,
+		0x003180, 0x8031E3, 0x803125, 0x003146, 0x8030A9, 0x0030CA, 0x00300C, 0x80306F,
+		0x8033B1, 0x0033D2, 0x003314, 0x803377, 0x003298, 0x8032FB, 0x80323D, 0x00325E,
+		0x803581, 0x0035E2, 0x003524, 0x803547, 0x0034A8, 0x8034CB, 0x80340D, 0x00346E,
+		0x0037B0, 0x8037D3, 0x803715, 0x003776, 0x803699, 0x0036FA, 0x00363C, 0x80365F,
+		0x8039E1, 0x003982, 0x003944, 0x803927, 0x0038C8, 0x8038AB, 0x80386D, 0x00380E,
+		0x003BD0, 0x803BB3, 0x803B75, 0x003B16, 0x803AF9, 0x003A9A, 0x003A5C, 0x803A3F,
+		0x003DE0, 0x803D83, 0x803D45, 0x003D26, 0x803CC9, 0x003CAA, 0x003C6C, 0x803C0F,
+		0x803FD1, 0x003FB2, 0x003F74, 0x803F17, 0x003EF8, 0x803E9B, 0x803E5D, 0x003E3E,
+	},
+	{30, 0x2030B9C7, false}: {
+		0x00000000, 0x2030B9C7, 0x2051CA49, 0x0061738E, 0x20932D55, 0x00A39492, 0x00C2E71C, 0x20F25EDB,
+		0x2116E36D, 0x01265AAA, 0x01472924, 0x217790E3, 0x0185CE38, 0x21B577FF, 0x21D40471, 0x01E4BDB6,
+		0x221D7F1D, 0x022DC6DA, 0x024CB554, 0x227C0C93, 0x028E5248, 0x22BEEB8F, 0x22DF9801, 0x02EF21C6,
+		0x030B9C70, 0x233B25B7, 0x235A5639, 0x036AEFFE, 0x2398B125, 0x03A808E2, 0x03C97B6C, 0x23F9C2AB,
+		0x240A47FD, 0x043AFE3A, 0x045B8DB4, 0x246B3473, 0x04996AA8, 0x24A9D36F, 0x24C8A0E1, 0x04F81926,
+		0x051CA490, 0x252C1D57, 0x254D6ED9, 0x057DD71E, 0x258F89C5, 0x05BF3002, 0x05DE438C, 0x25EEFA4B,
+		0x061738E0, 0x26278127, 0x2646F2A9, 0x06764B6E, 0x268415B5, 0x06B4AC72, 0x06D5DFFC, 0x26E5663B,
+		0x2701DB8D, 0x0731624A, 0x075011C4, 0x2760A803, 0x0792F6D8, 0x27A24F1F, 0x27C33C91, 0x07F38556,
+		0x2824363D, 0x08148FFA, 0x0875FC74, 0x284545B3, 0x08B71B68, 0x2887A2AF, 0x28E6D121, 0x08D668E6,
+		0x0932D550, 0x29026C97, 0x29631F19, 0x0953A6DE, 0x29A1F805, 0x099141C2, 0x09F0324C, 0x29C08B8B,
+		0x0A394920, 0x2A09F0E7, 0x2A688369, 0x0A583AAE, 0x2AAA6475, 0x0A9ADDB2, 0x0AFBAE3C, 0x2ACB17FB,
+		0x2B2FAA4D, 0x0B1F138A, 0x0B7E6004, 0x2B4ED9C3, 0x0BBC8718, 0x2B8C3EDF, 0x2BED4D51, 0x0BDDF496,
+		0x0C2E71C0, 0x2C1EC807, 0x2C7FBB89, 0x0C4F024E, 0x2CBD5C95, 0x0C8DE552, 0x0CEC96DC, 0x2CDC2F1B,
+		0x2D3892AD, 0x0D082B6A, 0x0D6958E4, 0x2D59E123, 0x0DABBFF8, 0x2D9B063F, 0x2DFA75B1, 0x0DCACC76,
+		0x2E330EDD, 0x0E03B71A, 0x0E62C494, 0x2E527D53, 0x0EA02388, 0x2E909A4F, 0x2EF1E9C1, 0x0EC15006,
+		0x0F25EDB0, 0x2F155477, 0x2F7427F9, 0x0F449E3E, 0x2FB6C0E5, 0x0F867922, 0x0FE70AAC, 0x2FD7B36B,
+		0x3078D5BD, 0x10486C7A, 0x10291FF4, 0x3019A633, 0x10EBF8E8, 0x30DB412F, 0x30BA32A1, 0x108A8B66,
+		0x116E36D0, 0x315E8F17, 0x313FFC99, 0x110F455E, 0x31FD1B85, 0x11CDA242, 0x11ACD1CC, 0x319C680B,
+		0x1265AAA0, 0x32551367, 0x323460E9, 0x1204D92E, 0x32F687F5, 0x12C63E32, 0x12A74DBC, 0x3297F47B,
+		0x337349CD, 0x1343F00A, 0x13228384, 0x33123A43, 0x13E06498, 0x33D0DD5F, 0x33B1AED1, 0x13811716,
+		0x14729240, 0x34422B87, 0x34235809, 0x1413E1CE, 0x34E1BF15, 0x14D106D2, 0x14B0755C, 0x3480CC9B,
+		0x3564712D, 0x1554C8EA, 0x1535BB64, 0x350502A3, 0x15F75C78, 0x35C7E5BF, 0x35A69631, 0x15962FF6,
+		0x366FED5D, 0x165F549A, 0x163E2714, 0x360E9ED3, 0x16FCC008, 0x36CC79CF, 0x36AD0A41, 0x169DB386,
+		0x17790E30, 0x3749B7F7, 0x3728C479, 0x17187DBE, 0x37EA2365, 0x17DA9AA2, 0x17BBE92C, 0x378B50EB,
+		0x185CE380, 0x386C5A47, 0x380D29C9, 0x183D900E, 0x38CFCED5, 0x18FF7712, 0x189E049C, 0x38AEBD5B,
+		0x394A00ED, 0x197AB92A, 0x191BCAA4, 0x392B7363, 0x19D92DB8, 0x39E9947F, 0x3988E7F1, 0x19B85E36,
+		0x3A419C9D, 0x1A71255A, 0x1A1056D4, 0x3A20EF13, 0x1AD2B1C8, 0x3AE2080F, 0x3A837B81, 0x1AB3C246,
+		0x1B577FF0, 0x3B67C637, 0x3B06B5B9, 0x1B360C7E, 0x3BC452A5, 0x1BF4EB62, 0x1B9598EC, 0x3BA5212B,
+		0x3C56A47D, 0x1C661DBA, 0x1C076E34, 0x3C37D7F3, 0x1CC58928, 0x3CF530EF, 0x3C944361, 0x1CA4FAA6,
+		0x1D404710, 0x3D70FED7, 0x3D118D59, 0x1D21349E, 0x3DD36A45, 0x1DE3D382, 0x1D82A00C, 0x3DB219CB,
+		0x1E4BDB60, 0x3E7B62A7, 0x3E1A1129, 0x1E2AA8EE, 0x3ED8F635, 0x1EE84FF2, 0x1E893C7C, 0x3EB985BB,
+		0x3F5D380D, 0x1F6D81CA, 0x1F0CF244, 0x3F3C4B83, 0x1FCE1558, 0x3FFEAC9F, 0x3F9FDF11, 0x1FAF66D6,
+	},
+	{31, 0x4C11DB7, false}: {
+		0x00000000, 0x04C11DB7, 0x09823B6E, 0x0D4326D9, 0x130476DC, 0x17C56B6B, 0x1A864DB2, 0x1E475005,
+		0x2608EDB8, 0x22C9F00F, 0x2F8AD6D6, 0x2B4BCB61, 0x350C9B64, 0x31CD86D3, 0x3C8EA00A, 0x384FBDBD,
+		0x4C11DB70, 0x48D0C6C7, 0x4593E01E, 0x4152FDA9, 0x5F15ADAC, 0x5BD4B01B, 0x569796C2, 0x52568B75,
+		0x6A1936C8, 0x6ED82B7F, 0x639B0DA6, 0x675A1011, 0x791D4014, 0x7DDC5DA3, 0x709F7B7A, 0x745E66CD,
+		0x1CE2AB57, 0x1823B6E0, 0x15609039, 0x11A18D8E, 0x0FE6DD8B, 0x0B27C03C, 0x0664E6E5, 0x02A5FB52,
+		0x3AEA46EF, 0x3E2B5B58, 0x33687D81, 0x37A96036, 0x29EE3033, 0x2D2F2D84, 0x206C0B5D, 0x24AD16EA,
+		0x50F37027, 0x54326D90, 0x59714B49, 0x5DB056FE, 0x43F706FB, 0x47361B4C, 0x4A753D95, 0x4EB42022,
+		0x76FB9D9F, 0x723A8028, 0x7F79A6F1, 0x7BB8BB46, 0x65FFEB43, 0x613EF6F4, 0x6C7DD02D, 0x68BCCD9A,
+		0x39C556AE, 0x3D044B19, 0x30476DC0, 0x34867077, 0x2AC12072, 0x2E003DC5, 0x23431B1C, 0x278206AB,
+		0x1FCDBB16, 0x1B0CA6A1, 0x164F8078, 0x128E9DCF, 0x0CC9CDCA, 0x0808D07D, 0x054BF6A4, 0x018AEB13,
+		0x75D48DDE, 0x71159069, 0x7C56B6B0, 0x7897AB07, 0x66D0FB02, 0x6211E6B5, 0x6F52C06C, 0x6B93DDDB,
+		0x53DC6066, 0x571D7DD1, 0x5A5E5B08, 0x5E9F46BF, 0x40D816BA, 0x44190B0D, 0x495A2DD4, 0x4D9B3063,
+		0x2527FDF9, 0x21E6E04E, 0x2CA5C697, 0x2864DB20, 0x36238B25, 0x32E29692, 0x3FA1B04B, 0x3B60ADFC,
+		0x032F1041, 0x07EE0DF6, 0x0AAD2B2F, 0x0E6C3698, 0x102B669D, 0x14EA7B2A, 0x19A95DF3, 0x1D684044,
+		0x69362689, 0x6DF73B3E, 0x60B41DE7, 0x64750050, 0x7A325055, 0x7EF34DE2, 0x73B06B3B, 0x7771768C,
+		0x4F3ECB31, 0x4BFFD686, 0x46BCF05F, 0x427DEDE8, 0x5C3ABDED, 0x58FBA05A, 0x55B88683, 0x51799B34,
+		0x738AAD5C, 0x774BB0EB, 0x7A089632, 0x7EC98B85, 0x608EDB80, 0x644FC637, 0x690CE0EE, 0x6DCDFD59,
+		0x558240E4, 0x51435D53, 0x5C007B8A, 0x58C1663D, 0x46863638, 0x42472B8F, 0x4F040D56, 0x4BC510E1,
+		0x3F9B762C, 0x3B5A6B9B, 0x36194D42, 0x32D850F5, 0x2C9F00F0, 0x285E1D47, 0x251D3B9E, 0x21DC2629,
+		0x19939B94, 0x1D528623, 0x1011A0FA, 0x14D0BD4D, 0x0A97ED48, 0x0E56F0FF, 0x0315D626, 0x07D4CB91,
+		0x6F68060B, 0x6BA91BBC, 0x66EA3D65, 0x622B20D2, 0x7C6C70D7, 0x78AD6D60, 0x75EE4BB9, 0x712F560E,
+		0x4960EBB3, 0x4DA1F604, 0x40E2D0DD, 0x4423CD6A, 0x5A649D6F, 0x5EA580D8, 0x53E6A601, 0x5727BBB6,
+		0x2379DD7B, 0x27B8C0CC, 0x2AFBE615, 0x2E3AFBA2, 0x307DABA7, 0x34BCB610, 0x39FF90C9, 0x3D3E8D7E,
+		0x057130C3, 0x01B02D74, 0x0CF30BAD, 0x0832161A, 0x1675461F, 0x12B45BA8, 0x1FF77D71, 0x1B3660C6,
+		0x4A4FFBF2, 0x4E8EE645, 0x43CDC09C, 0x470CDD2B, 0x594B8D2E, 0x5D8A9099, 0x50C9B640, 0x5408ABF7,
+		0x6C47164A, 0x68860BFD, 0x65C52D24, 0x61043093, 0x7F436096, 0x7B827D21, 0x76C15BF8, 0x7200464F,
+		0x065E2082, 0x029F3D35, 0x0FDC1BEC, 0x0B1D065B, 0x155A565E, 0x119B4BE9, 0x1CD86D30, 0x18197087,
+		0x2056CD3A, 0x2497D08D, 0x29D4F654, 0x2D15EBE3, 0x3352BBE6, 0x3793A651, 0x3AD08088, 0x3E119D3F,
+		0x56AD50A5, 0x526C4D12, 0x5F2F6BCB, 0x5BEE767C, 0x45A92679, 0x41683BCE, 0x4C2B1D17, 0x48EA00A0,
+		0x70A5BD1D, 0x7464A0AA, 0x79278673, 0x7DE69BC4, 0x63A1CBC1, 0x6760D676, 0x6A23F0AF, 0x6EE2ED18,
+		0x1ABC8BD5, 0x1E7D9662, 0x133EB0BB, 0x17FFAD0C, 0x09B8FD09, 0x0D79E0BE, 0x003AC667, 0x04FBDBD0,
+		0x3CB4666D, 0x38757BDA, 0x35365D03, 0x31F740B4, 0x2FB010B1, 0x2B710D06, 0x26322BDF, 0x22F33668,
+	},
+	{32, 0x814141AB, false}: {
+		0x00000000, 0x814141AB, 0x83C3C2FD, 0x02828356, 0x86C6C451, 0x078785FA, 0x050506AC, 0x84444707,
+		0x8CCCC909, 0x0D8D88A2, 0x0F0F0BF4, 0x8E4E4A5F, 0x0A0A0D58, 0x8B4B4CF3, 0x89C9CFA5, 0x08888E0E,
+		0x98D8D3B9, 0x19999212, 0x1B1B1144, 0x9A5A50EF, 0x1E1E17E8, 0x9F5F5643, 0x9DDDD515, 0x1C9C94BE,
+		0x14141AB0, 0x95555B1B, 0x97D7D84D, 0x169699E6, 0x92D2DEE1, 0x13939F4A, 0x11111C1C, 0x90505DB7,
+		0xB0F0E6D9, 0x31B1A772, 0x33332424, 0xB272658F, 0x36362288, 0xB7776323, 0xB5F5E075, 0x34B4A1DE,
+		0x3C3C2FD0, 0xBD7D6E7B, 0xBFFFED2D, 0x3EBEAC86, 0xBAFAEB81, 0x3BBBAA2A, 0x3939297C, 0xB87868D7,
+		0x28283560, 0xA96974CB, 0xABEBF79D, 0x2AAAB636, 0xAEEEF131, 0x2FAFB09A, 0x2D2D33CC, 0xAC6C7267,
+		0xA4E4FC69, 0x25A5BDC2, 0x27273E94, 0xA6667F3F, 0x22223838, 0xA3637993, 0xA1E1FAC5, 0x20A0BB6E,
+		0xE0A08C19, 0x61E1CDB2, 0x63634EE4, 0xE2220F4F, 0x66664848, 0xE72709E3, 0xE5A58AB5, 0x64E4CB1E,
+		0x6C6C4510, 0xED2D04BB, 0xEFAF87ED, 0x6EEEC646, 0xEAAA8141, 0x6BEBC0EA, 0x696943BC, 0xE8280217,
+		0x78785FA0, 0xF9391E0B, 0xFBBB9D5D, 0x7AFADCF6, 0xFEBE9BF1, 0x7FFFDA5A, 0x7D7D590C, 0xFC3C18A7,
+		0xF4B496A9, 0x75F5D702, 0x77775454, 0xF63615FF, 0x727252F8, 0xF3331353, 0xF1B19005, 0x70F0D1AE,
+		0x50506AC0, 0xD1112B6B, 0xD393A83D, 0x52D2E996, 0xD696AE91, 0x57D7EF3A, 0x55556C6C, 0xD4142DC7,
+		0xDC9CA3C9, 0x5DDDE262, 0x5F5F6134, 0xDE1E209F, 0x5A5A6798, 0xDB1B2633, 0xD999A565, 0x58D8E4CE,
+		0xC888B979, 0x49C9F8D2, 0x4B4B7B84, 0xCA0A3A2F, 0x4E4E7D28, 0xCF0F3C83, 0xCD8DBFD5, 0x4CCCFE7E,
+		0x44447070, 0xC50531DB, 0xC787B28D, 0x46C6F326, 0xC282B421, 0x43C3F58A, 0x414176DC, 0xC0003777,
+		0x40005999, 0xC1411832, 0xC3C39B64, 0x4282DACF, 0xC6C69DC8, 0x4787DC63, 0x45055F35, 0xC4441E9E,
+		0xCCCC9090, 0x4D8DD13B, 0x4F0F526D, 0xCE4E13C6, 0x4A0A54C1, 0xCB4B156A, 0xC9C9963C, 0x4888D797,
+		0xD8D88A20, 0x5999CB8B, 0x5B1B48DD, 0xDA5A0976, 0x5E1E4E71, 0xDF5F0FDA, 0xDDDD8C8C, 0x5C9CCD27,
+		0x54144329, 0xD5550282, 0xD7D781D4, 0x5696C07F, 0xD2D28778, 0x5393C6D3, 0x51114585, 0xD050042E,
+		0xF0F0BF40, 0x71B1FEEB, 0x73337DBD, 0xF2723C16, 0x76367B11, 0xF7773ABA, 0xF5F5B9EC, 0x74B4F847,
+		0x7C3C7649, 0xFD7D37E2, 0xFFFFB4B4, 0x7EBEF51F, 0xFAFAB218, 0x7BBBF3B3, 0x793970E5, 0xF878314E,
+		0x68286CF9, 0xE9692D52, 0xEBEBAE04, 0x6AAAEFAF, 0xEEEEA8A8, 0x6FAFE903, 0x6D2D6A55, 0xEC6C2BFE,
+		0xE4E4A5F0, 0x65A5E45B, 0x6727670D, 0xE66626A6, 0x622261A1, 0xE363200A, 0xE1E1A35C, 0x60A0E2F7,
+		0xA0A0D580, 0x21E1942B, 0x2363177D, 0xA22256D6, 0x266611D1, 0xA727507A, 0xA5A5D32C, 0x24E49287,
+		0x2C6C1C89, 0xAD2D5D22, 0xAFAFDE74, 0x2EEE9FDF, 0xAAAAD8D8, 0x2BEB9973, 0x29691A25, 0xA8285B8E,
+		0x38780639, 0xB9394792, 0xBBBBC4C4, 0x3AFA856F, 0xBEBEC268, 0x3FFF83C3, 0x3D7D0095, 0xBC3C413E,
+		0xB4B4CF30, 0x35F58E9B, 0x37770DCD, 0xB6364C66, 0x32720B61, 0xB3334ACA, 0xB1B1C99C, 0x30F08837,
+		0x10503359, 0x911172F2, 0x9393F1A4, 0x12D2B00F, 0x9696F708, 0x17D7B6A3, 0x155535F5, 0x9414745E,
+		0x9C9CFA50, 0x1DDDBBFB, 0x1F5F38AD, 0x9E1E7906, 0x1A5A3E01, 0x9B1B7FAA, 0x9999FCFC, 0x18D8BD57,
+		0x8888E0E0, 0x09C9A14B, 0x0B4B221D, 0x8A0A63B6, 0x0E4E24B1, 0x8F0F651A, 0x8D8DE64C, 0x0CCCA7E7,
+		0x044429E9, 0x85056842, 0x8787EB14, 0x06C6AABF, 0x8282EDB8, 0x03C3AC13, 0x01412F45, 0x80006EEE,
+	},
+	{32, 0xF4ACFB13, true}: {
+		0x00000000, 0x30850FF5, 0x610A1FEA, 0x518F101F, 0xC2143FD4, 0xF2913021, 0xA31E203E, 0x939B2FCB,
+		0x159615F7, 0x25131A02, 0x749C0A1D, 0x441905E8, 0xD7822A23, 0xE70725D6, 0xB68835C9, 0x860D3A3C,
+		0x2B2C2BEE, 0x1BA9241B, 0x4A263404, 0x7AA33BF1, 0xE938143A, 0xD9BD1BCF, 0x88320BD0, 0xB8B70425,
+		0x3EBA3E19, 0x0E3F31EC, 0x5FB021F3, 0x6F352E06, 0xFCAE01CD, 0xCC2B0E38, 0x9DA41E27, 0xAD2111D2,
+		0x565857DC, 0x66DD5829, 0x37524836, 0x07D747C3, 0x944C6808, 0xA4C967FD, 0xF54677E2, 0xC5C37817,
+		0x43CE422B, 0x734B4DDE, 0x22C45DC1, 0x12415234, 0x81DA7DFF, 0xB15F720A, 0xE0D06215, 0xD0556DE0,
+		0x7D747C32, 0x4DF173C7, 0x1C7E63D8, 0x2CFB6C2D, 0xBF6043E6, 0x8FE54C13, 0xDE6A5C0C, 0xEEEF53F9,
+		0x68E269C5, 0x58676630, 0x09E8762F, 0x396D79DA, 0xAAF65611, 0x9A7359E4, 0xCBFC49FB, 0xFB79460E,
+		0xACB0AFB8, 0x9C35A04D, 0xCDBAB052, 0xFD3FBFA7, 0x6EA4906C, 0x5E219F99, 0x0FAE8F86, 0x3F2B8073,
+		0xB926BA4F, 0x89A3B5BA, 0xD82CA5A5, 0xE8A9AA50, 0x7B32859B, 0x4BB78A6E, 0x1A389A71, 0x2ABD9584,
+		0x879C8456, 0xB7198BA3, 0xE6969BBC, 0xD6139449, 0x4588BB82, 0x750DB477, 0x2482A468, 0x1407AB9D,
+		0x920A91A1, 0xA28F9E54, 0xF3008E4B, 0xC38581BE, 0x501EAE75, 0x609BA180, 0x3114B19F, 0x0191BE6A,
+		0xFAE8F864, 0xCA6DF791, 0x9BE2E78E, 0xAB67E87B, 0x38FCC7B0, 0x0879C845, 0x59F6D85A, 0x6973D7AF,
+		0xEF7EED93, 0xDFFBE266, 0x8E74F279, 0xBEF1FD8C, 0x2D6AD247, 0x1DEFDDB2, 0x4C60CDAD, 0x7CE5C258,
+		0xD1C4D38A, 0xE141DC7F, 0xB0CECC60, 0x804BC395, 0x13D0EC5E, 0x2355E3AB, 0x72DAF3B4, 0x425FFC41,
+		0xC452C67D, 0xF4D7C988, 0xA558D997, 0x95DDD662, 0x0646F9A9, 0x36C3F65C, 0x674CE643, 0x57C9E9B6,
+		0xC8DF352F, 0xF85A3ADA, 0xA9D52AC5, 0x99502530, 0x0ACB0AFB, 0x3A4E050E, 0x6BC11511, 0x5B441AE4,
+		0xDD4920D8, 0xEDCC2F2D, 0xBC433F32, 0x8CC630C7, 0x1F5D1F0C, 0x2FD810F9, 0x7E5700E6, 0x4ED20F13,
+		0xE3F31EC1, 0xD3761134, 0x82F9012B, 0xB27C0EDE, 0x21E72115, 0x11622EE0, 0x40ED3EFF, 0x7068310A,
+		0xF6650B36, 0xC6E004C3, 0x976F14DC, 0xA7EA1B29, 0x347134E2, 0x04F43B17, 0x557B2B08, 0x65FE24FD,
+		0x9E8762F3, 0xAE026D06, 0xFF8D7D19, 0xCF0872EC, 0x5C935D27, 0x6C1652D2, 0x3D9942CD, 0x0D1C4D38,
+		0x8B117704, 0xBB9478F1, 0xEA1B68EE, 0xDA9E671B, 0x490548D0, 0x79804725, 0x280F573A, 0x188A58CF,
+		0xB5AB491D, 0x852E46E8, 0xD4A156F7, 0xE4245902, 0x77BF76C9, 0x473A793C, 0x16B56923, 0x263066D6,
+		0xA03D5CEA, 0x90B8531F, 0xC1374300, 0xF1B24CF5, 0x6229633E, 0x52AC6CCB, 0x03237CD4, 0x33A67321,
+		0x646F9A97, 0x54EA9562, 0x0565857D, 0x35E08A88, 0xA67BA543, 0x96FEAAB6, 0xC771BAA9, 0xF7F4B55C,
+		0x71F98F60, 0x417C8095, 0x10F3908A, 0x20769F7F, 0xB3EDB0B4, 0x8368BF41, 0xD2E7AF5E, 0xE262A0AB,
+		0x4F43B179, 0x7FC6BE8C, 0x2E49AE93, 0x1ECCA166, 0x8D578EAD, 0xBDD28158, 0xEC5D9147, 0xDCD89EB2,
+		0x5AD5A48E, 0x6A50AB7B, 0x3BDFBB64, 0x0B5AB491, 0x98C19B5A, 0xA84494AF, 0xF9CB84B0, 0xC94E8B45,
+		0x3237CD4B, 0x02B2C2BE, 0x533DD2A1, 0x63B8DD54, 0xF023F29F, 0xC0A6FD6A, 0x9129ED75, 0xA1ACE280,
+		0x27A1D8BC, 0x1724D749, 0x46ABC756, 0x762EC8A3, 0xE5B5E768, 0xD530E89D, 0x84BFF882, 0xB43AF777,
+		0x191BE6A5, 0x299EE950, 0x7811F94F, 0x4894F6BA, 0xDB0FD971, 0xEB8AD684, 0xBA05C69B, 0x8A80C96E,
+		0x0C8DF352, 0x3C08FCA7, 0x6D87ECB8, 0x5D02E34D, 0xCE99CC86, 0xFE1CC373, 0xAF93D36C, 0x9F16DC99,
+	},
+	{32, 0xA833982B, true}: {
+		0x00000000, 0x2BDDD04F, 0x57BBA09E, 0x7C6670D1, 0xAF77413C, 0x84AA9173, 0xF8CCE1A2, 0xD31131ED,
+		0xF6DD1A53, 0xDD00CA1C, 0xA166BACD, 0x8ABB6A82, 0x59AA5B6F, 0x72778B20, 0x0E11FBF1, 0x25CC2BBE,
+		0x4589AC8D, 0x6E547CC2, 0x12320C13, 0x39EFDC5C, 0xEAFEEDB1, 0xC1233DFE, 0xBD454D2F, 0x96989D60,
+		0xB354B6DE, 0x98896691, 0xE4EF1640, 0xCF32C60F, 0x1C23F7E2, 0x37FE27AD, 0x4B98577C, 0x60458733,
+		0x8B13591A, 0xA0CE8955, 0xDCA8F984, 0xF77529CB, 0x24641826, 0x0FB9C869, 0x73DFB8B8, 0x580268F7,
+		0x7DCE4349, 0x56139306, 0x2A75E3D7, 0x01A83398, 0xD2B90275, 0xF964D23A, 0x8502A2EB, 0xAEDF72A4,
+		0xCE9AF597, 0xE54725D8, 0x99215509, 0xB2FC8546, 0x61EDB4AB, 0x4A3064E4, 0x36561435, 0x1D8BC47A,
+		0x3847EFC4, 0x139A3F8B, 0x6FFC4F5A, 0x44219F15, 0x9730AEF8, 0xBCED7EB7, 0xC08B0E66, 0xEB56DE29,
+		0xBE152A1F, 0x95C8FA50, 0xE9AE8A81, 0xC2735ACE, 0x11626B23, 0x3ABFBB6C, 0x46D9CBBD, 0x6D041BF2,
+		0x48C8304C, 0x6315E003, 0x1F7390D2, 0x34AE409D, 0xE7BF7170, 0xCC62A13F, 0xB004D1EE, 0x9BD901A1,
+		0xFB9C8692, 0xD04156DD, 0xAC27260C, 0x87FAF643, 0x54EBC7AE, 0x7F3617E1, 0x03506730, 0x288DB77F,
+		0x0D419CC1, 0x269C4C8E, 0x5AFA3C5F, 0x7127EC10, 0xA236DDFD, 0x89EB0DB2, 0xF58D7D63, 0xDE50AD2C,
+		0x35067305, 0x1EDBA34A, 0x62BDD39B, 0x496003D4, 0x9A713239, 0xB1ACE276, 0xCDCA92A7, 0xE61742E8,
+		0xC3DB6956, 0xE806B919, 0x9460C9C8, 0xBFBD1987, 0x6CAC286A, 0x4771F825, 0x3B1788F4, 0x10CA58BB,
+		0x708FDF88, 0x5B520FC7, 0x27347F16, 0x0CE9AF59, 0xDFF89EB4, 0xF4254EFB, 0x88433E2A, 0xA39EEE65,
+		0x8652C5DB, 0xAD8F1594, 0xD1E96545, 0xFA34B50A, 0x292584E7, 0x02F854A8, 0x7E9E2479, 0x5543F436,
+		0xD419CC15, 0xFFC41C5A, 0x83A26C8B, 0xA87FBCC4, 0x7B6E8D29, 0x50B35D66, 0x2CD52DB7, 0x0708FDF8,
+		0x22C4D646, 0x09190609, 0x757F76D8, 0x5EA2A697, 0x8DB3977A, 0xA66E4735, 0xDA0837E4, 0xF1D5E7AB,
+		0x91906098, 0xBA4DB0D7, 0xC62BC006, 0xEDF61049, 0x3EE721A4, 0x153AF1EB, 0x695C813A, 0x42815175,
+		0x674D7ACB, 0x4C90AA84, 0x30F6DA55, 0x1B2B0A1A, 0xC83A3BF7, 0xE3E7EBB8, 0x9F819B69, 0xB45C4B26,
+		0x5F0A950F, 0x74D74540, 0x08B13591, 0x236CE5DE, 0xF07DD433, 0xDBA0047C, 0xA7C674AD, 0x8C1BA4E2,
+		0xA9D78F5C, 0x820A5F13, 0xFE6C2FC2, 0xD5B1FF8D, 0x06A0CE60, 0x2D7D1E2F, 0x511B6EFE, 0x7AC6BEB1,
+		0x1A833982, 0x315EE9CD, 0x4D38991C, 0x66E54953, 0xB5F478BE, 0x9E29A8F1, 0xE24FD820, 0xC992086F,
+		0xEC5E23D1, 0xC783F39E, 0xBBE5834F, 0x90385300, 0x432962ED, 0x68F4B2A2, 0x1492C273, 0x3F4F123C,
+		0x6A0CE60A, 0x41D13645, 0x3DB74694, 0x166A96DB, 0xC57BA736, 0xEEA67779, 0x92C007A8, 0xB91DD7E7,
+		0x9CD1FC59, 0xB70C2C16, 0xCB6A5CC7, 0xE0B78C88, 0x33A6BD65, 0x187B6D2A, 0x641D1DFB, 0x4FC0CDB4,
+		0x2F854A87, 0x04589AC8, 0x783EEA19, 0x53E33A56, 0x80F20BBB, 0xAB2FDBF4, 0xD749AB25, 0xFC947B6A,
+		0xD95850D4, 0xF285809B, 0x8EE3F04A, 0xA53E2005, 0x762F11E8, 0x5DF2C1A7, 0x2194B176, 0x0A496139,
+		0xE11FBF10, 0xCAC26F5F, 0xB6A41F8E, 0x9D79CFC1, 0x4E68FE2C, 0x65B52E63, 0x19D35EB2, 0x320E8EFD,
+		0x17C2A543, 0x3C1F750C, 0x407905DD, 0x6BA4D592, 0xB8B5E47F, 0x93683430, 0xEF0E44E1, 0xC4D394AE,
+		0xA496139D, 0x8F4BC3D2, 0xF32DB303, 0xD8F0634C, 0x0BE152A1, 0x203C82EE, 0x5C5AF23F, 0x77872270,
+		0x524B09CE, 0x7996D981, 0x05F0A950, 0x2E2D791F, 0xFD3C48F2, 0xD6E198BD, 0xAA87E86C, 0x815A3823,
+	},
+	{32, 0x4C11DB7, false}: {
+		0x00000000, 0x04C11DB7, 0x09823B6E, 0x0D4326D9, 0x130476DC, 0x17C56B6B, 0x1A864DB2, 0x1E475005,
+		0x2608EDB8, 0x22C9F00F, 0x2F8AD6D6, 0x2B4BCB61, 0x350C9B64, 0x31CD86D3, 0x3C8EA00A, 0x384FBDBD,
+		0x4C11DB70, 0x48D0C6C7, 0x4593E01E, 0x4152FDA9, 0x5F15ADAC, 0x5BD4B01B, 0x569796C2, 0x52568B75,
+		0x6A1936C8, 0x6ED82B7F, 0x639B0DA6, 0x675A1011, 0x791D4014, 0x7DDC5DA3, 0x709F7B7A, 0x745E66CD,
+		0x9823B6E0, 0x9CE2AB57, 0x91A18D8E, 0x95609039, 0x8B27C03C, 0x8FE6DD8B, 0x82A5FB52, 0x8664E6E5,
+		0xBE2B5B58, 0xBAEA46EF, 0xB7A96036, 0xB3687D81, 0xAD2F2D84, 0xA9EE3033, 0xA4AD16EA, 0xA06C0B5D,
+		0xD4326D90, 0xD0F37027, 0xDDB056FE, 0xD9714B49, 0xC7361B4C, 0xC3F706FB, 0xCEB42022, 0xCA753D95,
+		0xF23A8028, 0xF6FB9D9F, 0xFBB8BB46, 0xFF79A6F1, 0xE13EF6F4, 0xE5FFEB43, 0xE8BCCD9A, 0xEC7DD02D,
+		0x34867077, 0x30476DC0, 0x3D044B19, 0x39C556AE, 0x278206AB, 0x23431B1C, 0x2E003DC5, 0x2AC12072,
+		0x128E9DCF, 0x164F8078, 0x1B0CA6A1, 0x1FCDBB16, 0x018AEB13, 0x054BF6A4, 0x0808D07D, 0x0CC9CDCA,
+		0x7897AB07, 0x7C56B6B0, 0x71159069, 0x75D48DDE, 0x6B93DDDB, 0x6F52C06C, 0x6211E6B5, 0x66D0FB02,
+		0x5E9F46BF, 0x5A5E5B08, 0x571D7DD1, 0x53DC6066, 0x4D9B3063, 0x495A2DD4, 0x44190B0D, 0x40D816BA,
+		0xACA5C697, 0xA864DB20, 0xA527FDF9, 0xA1E6E04E, 0xBFA1B04B, 0xBB60ADFC, 0xB6238B25, 0xB2E29692,
+		0x8AAD2B2F, 0x8E6C3698, 0x832F1041, 0x87EE0DF6, 0x99A95DF3, 0x9D684044, 0x902B669D, 0x94EA7B2A,
+		0xE0B41DE7, 0xE4750050, 0xE9362689, 0xEDF73B3E, 0xF3B06B3B, 0xF771768C, 0xFA325055, 0xFEF34DE2,
+		0xC6BCF05F, 0xC27DEDE8, 0xCF3ECB31, 0xCBFFD686, 0xD5B88683, 0xD1799B34, 0xDC3ABDED, 0xD8FBA05A,
+		0x690CE0EE, 0x6DCDFD59, 0x608EDB80, 0x644FC637, 0x7A089632, 0x7EC98B85, 0x738AAD5C, 0x774BB0EB,
+		0x4F040D56, 0x4BC510E1, 0x46863638, 0x42472B8F, 0x5C007B8A, 0x58C1663D, 0x558240E4, 0x51435D53,
+		0x251D3B9E, 0x21DC2629, 0x2C9F00F0, 0x285E1D47, 0x36194D42, 0x32D850F5, 0x3F9B762C, 0x3B5A6B9B,
+		0x0315D626, 0x07D4CB91, 0x0A97ED48, 0x0E56F0FF, 0x1011A0FA, 0x14D0BD4D, 0x19939B94, 0x1D528623,
+		0xF12F560E, 0xF5EE4BB9, 0xF8AD6D60, 0xFC6C70D7, 0xE22B20D2, 0xE6EA3D65, 0xEBA91BBC, 0xEF68060B,
+		0xD727BBB6, 0xD3E6A601, 0xDEA580D8, 0xDA649D6F, 0xC423CD6A, 0xC0E2D0DD, 0xCDA1F604, 0xC960EBB3,
+		0xBD3E8D7E, 0xB9FF90C9, 0xB4BCB610, 0xB07DABA7, 0xAE3AFBA2, 0xAAFBE615, 0xA7B8C0CC, 0xA379DD7B,
+		0x9B3660C6, 0x9FF77D71, 0x92B45BA8, 0x9675461F, 0x8832161A, 0x8CF30BAD, 0x81B02D74, 0x857130C3,
+		0x5D8A9099, 0x594B8D2E, 0x5408ABF7, 0x50C9B640, 0x4E8EE645, 0x4A4FFBF2, 0x470CDD2B, 0x43CDC09C,
+		0x7B827D21, 0x7F436096, 0x7200464F, 0x76C15BF8, 0x68860BFD, 0x6C47164A, 0x61043093, 0x65C52D24,
+		0x119B4BE9, 0x155A565E, 0x18197087, 0x1CD86D30, 0x029F3D35, 0x065E2082, 0x0B1D065B, 0x0FDC1BEC,
+		0x3793A651, 0x3352BBE6, 0x3E119D3F, 0x3AD08088, 0x2497D08D, 0x2056CD3A, 0x2D15EBE3, 0x29D4F654,
+		0xC5A92679, 0xC1683BCE, 0xCC2B1D17, 0xC8EA00A0, 0xD6AD50A5, 0xD26C4D12, 0xDF2F6BCB, 0xDBEE767C,
+		0xE3A1CBC1, 0xE760D676, 0xEA23F0AF, 0xEEE2ED18, 0xF0A5BD1D, 0xF464A0AA, 0xF9278673, 0xFDE69BC4,
+		0x89B8FD09, 0x8D79E0BE, 0x803AC667, 0x84FBDBD0, 0x9ABC8BD5, 0x9E7D9662, 0x933EB0BB, 0x97FFAD0C,
+		0xAFB010B1, 0xAB710D06, 0xA6322BDF, 0xA2F33668, 0xBCB4666D, 0xB8757BDA, 0xB5365D03, 0xB1F740B4,
+	},
+	{32, 0x8001801B, true}: {
+		0x00000000, 0x90910101, 0x91210201, 0x01B00300, 0x92410401, 0x02D00500, 0x03600600, 0x93F10701,
+		0x94810801, 0x04100900, 0x05A00A00, 0x95310B01, 0x06C00C00, 0x96510D01, 0x97E10E01, 0x07700F00,
+		0x99011001, 0x09901100, 0x08201200, 0x98B11301, 0x0B401400, 0x9BD11501, 0x9A611601, 0x0AF01700,
+		0x0D801800, 0x9D111901, 0x9CA11A01, 0x0C301B00, 0x9FC11C01, 0x0F501D00, 0x0EE01E00, 0x9E711F01,
+		0x82012001, 0x12902100, 0x13202200, 0x83B12301, 0x10402400, 0x80D12501, 0x81612601, 0x11F02700,
+		0x16802800, 0x86112901, 0x87A12A01, 0x17302B00, 0x84C12C01, 0x14502D00, 0x15E02E00, 0x85712F01,
+		0x1B003000, 0x8B913101, 0x8A213201, 0x1AB03300, 0x89413401, 0x19D03500, 0x18603600, 0x88F13701,
+		0x8F813801, 0x1F103900, 0x1EA03A00, 0x8E313B01, 0x1DC03C00, 0x8D513D01, 0x8CE13E01, 0x1C703F00,
+		0xB4014001, 0x24904100, 0x25204200, 0xB5B14301, 0x26404400, 0xB6D14501, 0xB7614601, 0x27F04700,
+		0x20804800, 0xB0114901, 0xB1A14A01, 0x21304B00, 0xB2C14C01, 0x22504D00, 0x23E04E00, 0xB3714F01,
+		0x2D005000, 0xBD915101, 0xBC215201, 0x2CB05300, 0xBF415401, 0x2FD05500, 0x2E605600, 0xBEF15701,
+		0xB9815801, 0x29105900, 0x28A05A00, 0xB8315B01, 0x2BC05C00, 0xBB515D01, 0xBAE15E01, 0x2A705F00,
+		0x36006000, 0xA6916101, 0xA7216201, 0x37B06300, 0xA4416401, 0x34D06500, 0x35606600, 0xA5F16701,
+		0xA2816801, 0x32106900, 0x33A06A00, 0xA3316B01, 0x30C06C00, 0xA0516D01, 0xA1E16E01, 0x31706F00,
+		0xAF017001, 0x3F907100, 0x3E207200, 0xAEB17301, 0x3D407400, 0xADD17501, 0xAC617601, 0x3CF07700,
+		0x3B807800, 0xAB117901, 0xAAA17A01, 0x3A307B00, 0xA9C17C01, 0x39507D00, 0x38E07E00, 0xA8717F01,
+		0xD8018001, 0x48908100, 0x49208200, 0xD9B18301, 0x4A408400, 0xDAD18501, 0xDB618601, 0x4BF08700,
+		0x4C808800, 0xDC118901, 0xDDA18A01, 0x4D308B00, 0xDEC18C01, 0x4E508D00, 0x4FE08E00, 0xDF718F01,
+		0x41009000, 0xD1919101, 0xD0219201, 0x40B09300, 0xD3419401, 0x43D09500, 0x42609600, 0xD2F19701,
+		0xD5819801, 0x45109900, 0x44A09A00, 0xD4319B01, 0x47C09C00, 0xD7519D01, 0xD6E19E01, 0x46709F00,
+		0x5A00A000, 0xCA91A101, 0xCB21A201, 0x5BB0A300, 0xC841A401, 0x58D0A500, 0x5960A600, 0xC9F1A701,
+		0xCE81A801, 0x5E10A900, 0x5FA0AA00, 0xCF31AB01, 0x5CC0AC00, 0xCC51AD01, 0xCDE1AE01, 0x5D70AF00,
+		0xC301B001, 0x5390B100, 0x5220B200, 0xC2B1B301, 0x5140B400, 0xC1D1B501, 0xC061B601, 0x50F0B700,
+		0x5780B800, 0xC711B901, 0xC6A1BA01, 0x5630BB00, 0xC5C1BC01, 0x5550BD00, 0x54E0BE00, 0xC471BF01,
+		0x6C00C000, 0xFC91C101, 0xFD21C201, 0x6DB0C300, 0xFE41C401, 0x6ED0C500, 0x6F60C600, 0xFFF1C701,
+		0xF881C801, 0x6810C900, 0x69A0CA00, 0xF931CB01, 0x6AC0CC00, 0xFA51CD01, 0xFBE1CE01, 0x6B70CF00,
+		0xF501D001, 0x6590D100, 0x6420D200, 0xF4B1D301, 0x6740D400, 0xF7D1D501, 0xF661D601, 0x66F0D700,
+		0x6180D800, 0xF111D901, 0xF0A1DA01, 0x6030DB00, 0xF3C1DC01, 0x6350DD00, 0x62E0DE00, 0xF271DF01,
+		0xEE01E001, 0x7E90E100, 0x7F20E200, 0xEFB1E301, 0x7C40E400, 0xECD1E501, 0xED61E601, 0x7DF0E700,
+		0x7A80E800, 0xEA11E901, 0xEBA1EA01, 0x7B30EB00, 0xE8C1EC01, 0x7850ED00, 0x79E0EE00, 0xE971EF01,
+		0x7700F000, 0xE791F101, 0xE621F201, 0x76B0F300, 0xE541F401, 0x75D0F500, 0x7460F600, 0xE4F1F701,
+		0xE381F801, 0x7310F900, 0x72A0FA00, 0xE231FB01, 0x71C0FC00, 0xE151FD01, 0xE0E1FE01, 0x7070FF00,
+	},
+	{32, 0x1EDC6F41, true}: {
+		0x00000000, 0xF26B8303, 0xE13B70F7, 0x1350F3F4, 0xC79A971F, 0x35F1141C, 0x26A1E7E8, 0xD4CA64EB,
+		0x8AD958CF, 0x78B2DBCC, 0x6BE22838, 0x9989AB3B, 0x4D43CFD0, 0xBF284CD3, 0xAC78BF27, 0x5E133C24,
+		0x105EC76F, 0xE235446C, 0xF165B798, 0x030E349B, 0xD7C45070, 0x25AFD373, 0x36FF2087, 0xC494A384,
+		0x9A879FA0, 0x68EC1CA3, 0x7BBCEF57, 0x89D76C54, 0x5D1D08BF, 0xAF768BBC, 0xBC267848, 0x4E4DFB4B,
+		0x20BD8EDE, 0xD2D60DDD, 0xC186FE29, 0x33ED7D2A, 0xE72719C1, 0x154C9AC2, 0x061C6936, 0xF477EA35,
+		0xAA64D611, 0x580F5512, 0x4B5FA6E6, 0xB93425E5, 0x6DFE410E, 0x9F95C20D, 0x8CC531F9, 0x7EAEB2FA,
+		0x30E349B1, 0xC288CAB2, 0xD1D83946, 0x23B3BA45, 0xF779DEAE, 0x05125DAD, 0x1642AE59, 0xE4292D5A,
+		0xBA3A117E, 0x4851927D, 0x5B016189, 0xA96AE28A, 0x7DA08661, 0x8FCB0562, 0x9C9BF696, 0x6EF07595,
+		0x417B1DBC, 0xB3109EBF, 0xA0406D4B, 0x522BEE48, 0x86E18AA3, 0x748A09A0, 0x67DAFA54, 0x95B17957,
+		0xCBA24573, 0x39C9C670, 0x2A993584, 0xD8F2B687, 0x0C38D26C, 0xFE53516F, 0xED03A29B, 0x1F682198,
+		0x5125DAD3, 0xA34E59D0, 0xB01EAA24, 0x42752927, 0x96BF4DCC, 0x64D4CECF, 0x77843D3B, 0x85EFBE38,
+		0xDBFC821C, 0x2997011F, 0x3AC7F2EB, 0xC8AC71E8, 0x1C661503, 0xEE0D9600, 0xFD5D65F4, 0x0F36E6F7,
+		0x61C69362, 0x93AD1061, 0x80FDE395, 0x72966096, 0xA65C047D, 0x5437877E, 0x4767748A, 0xB50CF789,
+		0xEB1FCBAD, 0x197448AE, 0x0A24BB5A, 0xF84F3859, 0x2C855CB2, 0xDEEEDFB1, 0xCDBE2C45, 0x3FD5AF46,
+		0x7198540D, 0x83F3D70E, 0x90A324FA, 0x62C8A7F9, 0xB602C312, 0x44694011, 0x5739B3E5, 0xA55230E6,
+		0xFB410CC2, 0x092A8FC1, 0x1A7A7C35, 0xE811FF36, 0x3CDB9BDD, 0xCEB018DE, 0xDDE0EB2A, 0x2F8B6829,
+		0x82F63B78, 0x709DB87B, 0x63CD4B8F, 0x91A6C88C, 0x456CAC67, 0xB7072F64, 0xA457DC90, 0x563C5F93,
+		0x082F63B7, 0xFA44E0B4, 0xE9141340, 0x1B7F9043, 0xCFB5F4A8, 0x3DDE77AB, 0x2E8E845F, 0xDCE5075C,
+		0x92A8FC17, 0x60C37F14, 0x73938CE0, 0x81F80FE3, 0x55326B08, 0xA759E80B, 0xB4091BFF, 0x466298FC,
+		0x1871A4D8, 0xEA1A27DB, 0xF94AD42F, 0x0B21572C, 0xDFEB33C7, 0x2D80B0C4, 0x3ED04330, 0xCCBBC033,
+		0xA24BB5A6, 0x502036A5, 0x4370C551, 0xB11B4652, 0x65D122B9, 0x97BAA1BA, 0x84EA524E, 0x7681D14D,
+		0x2892ED69, 0xDAF96E6A, 0xC9A99D9E, 0x3BC21E9D, 0xEF087A76, 0x1D63F975, 0x0E330A81, 0xFC588982,
+		0xB21572C9, 0x407EF1CA, 0x532E023E, 0xA145813D, 0x758FE5D6, 0x87E466D5, 0x94B49521, 0x66DF1622,
+		0x38CC2A06, 0xCAA7A905, 0xD9F75AF1, 0x2B9CD9F2, 0xFF56BD19, 0x0D3D3E1A, 0x1E6DCDEE, 0xEC064EED,
+		0xC38D26C4, 0x31E6A5C7, 0x22B65633, 0xD0DDD530, 0x0417B1DB, 0xF67C32D8, 0xE52CC12C, 0x1747422F,
+		0x49547E0B, 0xBB3FFD08, 0xA86F0EFC, 0x5A048DFF, 0x8ECEE914, 0x7CA56A17, 0x6FF599E3, 0x9D9E1AE0,
+		0xD3D3E1AB, 0x21B862A8, 0x32E8915C, 0xC083125F, 0x144976B4, 0xE622F5B7, 0xF5720643, 0x07198540,
+		0x590AB964, 0xAB613A67, 0xB831C993, 0x4A5A4A90, 0x9E902E7B, 0x6CFBAD78, 0x7FAB5E8C, 0x8DC0DD8F,
+		0xE330A81A, 0x115B2B19, 0x020BD8ED, 0xF0605BEE, 0x24AA3F05, 0xD6C1BC06, 0xC5914FF2, 0x37FACCF1,
+		0x69E9F0D5, 0x9B8273D6, 0x88D28022, 0x7AB90321, 0xAE7367CA, 0x5C18E4C9, 0x4F48173D, 0xBD23943E,
+		0xF36E6F75, 0x0105EC76, 0x12551F82, 0xE03E9C81, 0x34F4F86A, 0xC69F7B69, 0xD5CF889D, 0x27A40B9E,
+		0x79B737BA, 0x8BDCB4B9, 0x988C474D, 0x6AE7C44E, 0xBE2DA0A5, 0x4C4623A6, 0x5F16D052, 0xAD7D5351,
+	},
+	{32, 0x4C11DB7, true}: {
+		0x00000000, 0x77073096, 0xEE0E612C, 0x990951BA, 0x076DC419, 0x706AF48F, 0xE963A535, 0x9E6495A3,
+		0x0EDB8832, 0x79DCB8A4, 0xE0D5E91E, 0x97D2D988, 0x09B64C2B, 0x7EB17CBD, 0xE7B82D07, 0x90BF1D91,
+		0x1DB71064, 0x6AB020F2, 0xF3B97148, 0x84BE41DE, 0x1ADAD47D, 0x6DDDE4EB, 0xF4D4B551, 0x83D385C7,
+		0x136C9856, 0x646BA8C0, 0xFD62F97A, 0x8A65C9EC, 0x14015C4F, 0x63066CD9, 0xFA0F3D63, 0x8D080DF5,
+		0x3B6E20C8, 0x4C69105E, 0xD56041E4, 0xA2677172, 0x3C03E4D1, 0x4B04D447, 0xD20D85FD, 0xA50AB56B,
+		0x35B5A8FA, 0x42B2986C, 0xDBBBC9D6, 0xACBCF940, 0x32D86CE3, 0x45DF5C75, 0xDCD60DCF, 0xABD13D59,
+		0x26D930AC, 0x51DE003A, 0xC8D75180, 0xBFD06116, 0x21B4F4B5, 0x56B3C423, 0xCFBA9599, 0xB8BDA50F,
+		0x2802B89E, 0x5F058808, 0xC60CD9B2, 0xB10BE924, 0x2F6F7C87, 0x58684C11, 0xC1611DAB, 0xB6662D3D,
+		0x76DC4190, 0x01DB7106, 0x98D220BC, 0xEFD5102A, 0x71B18589, 0x06B6B51F, 0x9FBFE4A5, 0xE8B8D433,
+		0x7807C9A2, 0x0F00F934, 0x9609A88E, 0xE10E9818, 0x7F6A0DBB, 0x086D3D2D, 0x91646C97, 0xE6635C01,
+		0x6B6B51F4, 0x1C6C6162, 0x856530D8, 0xF262004E, 0x6C0695ED, 0x1B01A57B, 0x8208F4C1, 0xF50FC457,
+		0x65B0D9C6, 0x12B7E950, 0x8BBEB8EA, 0xFCB9887C, 0x62DD1DDF, 0x15DA2D49, 0x8CD37CF3, 0xFBD44C65,
+		0x4DB26158, 0x3AB551CE, 0xA3BC0074, 0xD4BB30E2, 0x4ADFA541, 0x3DD895D7, 0xA4D1C46D, 0xD3D6F4FB,
+		0x4369E96A, 0x346ED9FC, 0xAD678846, 0xDA60B8D0, 0x44042D73, 0x33031DE5, 0xAA0A4C5F, 0xDD0D7CC9,
+		0x5005713C, 0x270241AA, 0xBE0B1010, 0xC90C2086, 0x5768B525, 0x206F85B3, 0xB966D409, 0xCE61E49F,
+		0x5EDEF90E, 0x29D9C998, 0xB0D09822, 0xC7D7A8B4, 0x59B33D17, 0x2EB40D81, 0xB7BD5C3B, 0xC0BA6CAD,
+		0xEDB88320, 0x9ABFB3B6, 0x03B6E20C, 0x74B1D29A, 0xEAD54739, 0x9DD277AF, 0x04DB2615, 0x73DC1683,
+		0xE3630B12, 0x94643B84, 0x0D6D6A3E, 0x7A6A5AA8, 0xE40ECF0B, 0x9309FF9D, 0x0A00AE27, 0x7D079EB1,
+		0xF00F9344, 0x8708A3D2, 0x1E01F268, 0x6906C2FE, 0xF762575D, 0x806567CB, 0x196C3671, 0x6E6B06E7,
+		0xFED41B76, 0x89D32BE0, 0x10DA7A5A, 0x67DD4ACC, 0xF9B9DF6F, 0x8EBEEFF9, 0x17B7BE43, 0x60B08ED5,
+		0xD6D6A3E8, 0xA1D1937E, 0x38D8C2C4, 0x4FDFF252, 0xD1BB67F1, 0xA6BC5767, 0x3FB506DD, 0x48B2364B,
+		0xD80D2BDA, 0xAF0A1B4C, 0x36034AF6, 0x41047A60, 0xDF60EFC3, 0xA867DF55, 0x316E8EEF, 0x4669BE79,
+		0xCB61B38C, 0xBC66831A, 0x256FD2A0, 0x5268E236, 0xCC0C7795, 0xBB0B4703, 0x220216B9, 0x5505262F,
+		0xC5BA3BBE, 0xB2BD0B28, 0x2BB45A92, 0x5CB36A04, 0xC2D7FFA7, 0xB5D0CF31, 0x2CD99E8B, 0x5BDEAE1D,
+		0x9B64C2B0, 0xEC63F226, 0x756AA39C, 0x026D930A, 0x9C0906A9, 0xEB0E363F, 0x72076785, 0x05005713,
+		0x95BF4A82, 0xE2B87A14, 0x7BB12BAE, 0x0CB61B38, 0x92D28E9B, 0xE5D5BE0D, 0x7CDCEFB7, 0x0BDBDF21,
+		0x86D3D2D4, 0xF1D4E242, 0x68DDB3F8, 0x1FDA836E, 0x81BE16CD, 0xF6B9265B, 0x6FB077E1, 0x18B74777,
+		0x88085AE6, 0xFF0F6A70, 0x66063BCA, 0x11010B5C, 0x8F659EFF, 0xF862AE69, 0x616BFFD3, 0x166CCF45,
+		0xA00AE278, 0xD70DD2EE, 0x4E048354, 0x3903B3C2, 0xA7672661, 0xD06016F7, 0x4969474D, 0x3E6E77DB,
+		0xAED16A4A, 0xD9D65ADC, 0x40DF0B66, 0x37D83BF0, 0xA9BCAE53, 0xDEBB9EC5, 0x47B2CF7F, 0x30B5FFE9,
+		0xBDBDF21C, 0xCABAC28A, 0x53B39330, 0x24B4A3A6, 0xBAD03605, 0xCDD70693, 0x54DE5729, 0x23D967BF,
+		0xB3667A2E, 0xC4614AB8, 0x5D681B02, 0x2A6F2B94, 0xB40BBE37, 0xC30C8EA1, 0x5A05DF1B, 0x2D02EF8D,
+	},
+	{32, 0xAF, false}: {
+		0x00000000, 0x000000AF, 0x0000015E, 0x000001F1, 0x000002BC, 0x00000213, 0x000003E2, 0x0000034D,
+		0x00000578, 0x000005D7, 0x00000426, 0x00000489, 0x000007C4, 0x0000076B, 0x0000069A, 0x00000635,
+		0x00000AF0, 0x00000A5F, 0x00000BAE, 0x00000B01, 0x0000084C, 0x000008E3, 0x00000912, 0x000009BD,
+		0x00000F88, 0x00000F27, 0x00000ED6, 0x00000E79, 0x00000D34, 0x00000D9B, 0x00000C6A, 0x00000CC5,
+		0x000015E0, 0x0000154F, 0x000014BE, 0x00001411, 0x0000175C, 0x000017F3, 0x00001602, 0x000016AD,
+		0x00001098, 0x00001037, 0x000011C6, 0x00001169, 0x00001224, 0x0000128B, 0x0000137A, 0x000013D5,
+		0x00001F10, 0x00001FBF, 0x00001E4E, 0x00001EE1, 0x00001DAC, 0x00001D03, 0x00001CF2, 0x00001C5D,
+		0x00001A68, 0x00001AC7, 0x00001B36, 0x00001B99, 0x000018D4, 0x0000187B, 0x0000198A, 0x00001925,
+		0x00002BC0, 0x00002B6F, 0x00002A9E, 0x00002A31, 0x0000297C, 0x000029D3, 0x00002822, 0x0000288D,
+		0x00002EB8, 0x00002E17, 0x00002FE6, 0x00002F49, 0x00002C04, 0x00002CAB, 0x00002D5A, 0x00002DF5,
+		0x00002130, 0x0000219F, 0x0000206E, 0x000020C1, 0x0000238C, 0x00002323, 0x000022D2, 0x0000227D,
+		0x00002448, 0x000024E7, 0x00002516, 0x000025B9, 0x000026F4, 0x0000265B, 0x000027AA, 0x00002705,
+		0x00003E20, 0x00003E8F, 0x00003F7E, 0x00003FD1, 0x00003C9C, 0x00003C33, 0x00003DC2, 0x00003D6D,
+		0x00003B58, 0x00003BF7, 0x00003A06, 0x00003AA9, 0x000039E4, 0x0000394B, 0x000038BA, 0x00003815,
+		0x000034D0, 0x0000347F, 0x0000358E, 0x00003521, 0x0000366C, 0x000036C3, 0x00003732, 0x0000379D,
+		0x000031A8, 0x00003107, 0x000030F6, 0x00003059, 0x00003314, 0x000033BB, 0x0000324A, 0x000032E5,
+		0x00005780, 0x0000572F, 0x000056DE, 0x00005671, 0x0000553C, 0x00005593, 0x00005462, 0x000054CD,
+		0x000052F8, 0x00005257, 0x000053A6, 0x00005309, 0x00005044, 0x000050EB, 0x0000511A, 0x000051B5,
+		0x00005D70, 0x00005DDF, 0x00005C2E, 0x00005C81, 0x00005FCC, 0x00005F63, 0x00005E92, 0x00005E3D,
+		0x00005808, 0x000058A7, 0x00005956, 0x000059F9, 0x00005AB4, 0x00005A1B, 0x00005BEA, 0x00005B45,
+		0x00004260, 0x000042CF, 0x0000433E, 0x00004391, 0x000040DC, 0x00004073, 0x00004182, 0x0000412D,
+		0x00004718, 0x000047B7, 0x00004646, 0x000046E9, 0x000045A4, 0x0000450B, 0x000044FA, 0x00004455,
+		0x00004890, 0x0000483F, 0x000049CE, 0x00004961, 0x00004A2C, 0x00004A83, 0x00004B72, 0x00004BDD,
+		0x00004DE8, 0x00004D47, 0x00004CB6, 0x00004C19, 0x00004F54, 0x00004FFB, 0x00004E0A, 0x00004EA5,
+		0x00007C40, 0x00007CEF, 0x00007D1E, 0x00007DB1, 0x00007EFC, 0x00007E53, 0x00007FA2, 0x00007F0D,
+		0x00007938, 0x00007997, 0x00007866, 0x000078C9, 0x00007B84, 0x00007B2B, 0x00007ADA, 0x00007A75,
+		0x000076B0, 0x0000761F, 0x000077EE, 0x00007741, 0x0000740C, 0x000074A3, 0x00007552, 0x000075FD,
+		0x000073C8, 0x00007367, 0x00007296, 0x00007239, 0x00007174, 0x000071DB, 0x0000702A, 0x00007085,
+		0x000069A0, 0x0000690F, 0x000068FE, 0x00006851, 0x00006B1C, 0x00006BB3, 0x00006A42, 0x00006AED,
+		0x00006CD8, 0x00006C77, 0x00006D86, 0x00006D29, 0x00006E64, 0x00006ECB, 0x00006F3A, 0x00006F95,
+		0x00006350, 0x000063FF, 0x0000620E, 0x000062A1, 0x000061EC, 0x00006143, 0x000060B2, 0x0000601D,
+		0x00006628, 0x00006687, 0x00006776, 0x000067D9, 0x00006494, 0x0000643B, 0x000065CA, 0x00006565,
+	},
+	{40, 0x4820009, false}: {
+		0x0000000000, 0x0004820009, 0x0009040012, 0x000D86001B,
+		0x0012080024, 0x00168A002D, 0x001B0C0036, 0x001F8E003F,
+		0x0024100048, 0x0020920041, 0x002D14005A, 0x0029960053,
+		0x003618006C, 0x00329A0065, 0x003F1C007E, 0x003B9E0077,
+		0x0048200090, 0x004CA20099, 0x0041240082, 0x0045A6008B,
+		0x005A2800B4, 0x005EAA00BD, 0x00532C00A6, 0x0057AE00AF,
+		0x006C3000D8, 0x0068B200D1, 0x00653400CA, 0x0061B600C3,
+		0x007E3800FC, 0x007ABA00F5, 0x00773C00EE, 0x0073BE00E7,
+		0x0090400120, 0x0094C20129, 0x0099440132, 0x009DC6013B,
+		0x0082480104, 0x0086CA010D, 0x008B4C0116, 0x008FCE011F,
+		0x00B4500168, 0x00B0D20161, 0x00BD54017A, 0x00B9D60173,
+		0x00A658014C, 0x00A2DA0145, 0x00AF5C015E, 0x00ABDE0157,
+		0x00D86001B0, 0x00DCE201B9, 0x00D16401A2, 0x00D5E601AB,
+		0x00CA680194, 0x00CEEA019D, 0x00C36C0186, 0x00C7EE018F,
+		0x00FC7001F8, 0x00F8F201F1, 0x00F57401EA, 0x00F1F601E3,
+		0x00EE7801DC, 0x00EAFA01D5, 0x00E77C01CE, 0x00E3FE01C7,
+		0x0120800240, 0x0124020249, 0x0129840252, 0x012D06025B,
+		0x0132880264, 0x01360A026D, 0x013B8C0276, 0x013F0E027F,
+		0x0104900208, 0x0100120201, 0x010D94021A, 0x0109160213,
+		0x011698022C, 0x01121A0225, 0x011F9C023E, 0x011B1E0237,
+		0x0168A002D0, 0x016C2202D9, 0x0161A402C2, 0x01652602CB,
+		0x017AA802F4, 0x017E2A02FD, 0x0173AC02E6, 0x01772E02EF,
+		0x014CB00298, 0x0148320291, 0x0145B4028A, 0x0141360283,
+		0x015EB802BC, 0x015A3A02B5, 0x0157BC02AE, 0x01533E02A7,
+		0x01B0C00360, 0x01B4420369, 0x01B9C40372, 0x01BD46037B,
+		0x01A2C80344, 0x01A64A034D, 0x01ABCC0356, 0x01AF4E035F,
+		0x0194D00328, 0x0190520321, 0x019DD4033A, 0x0199560333,
+		0x0186D8030C, 0x01825A0305, 0x018FDC031E, 0x018B5E0317,
+		0x01F8E003F0, 0x01FC6203F9, 0x01F1E403E2, 0x01F56603EB,
+		0x01EAE803D4, 0x01EE6A03DD, 0x01E3EC03C6, 0x01E76E03CF,
+		0x01DCF003B8, 0x01D87203B1, 0x01D5F403AA, 0x01D17603A3,
+		0x01CEF8039C, 0x01CA7A0395, 0x01C7FC038E, 0x01C37E0387,
+		0x0241000480, 0x0245820489, 0x0248040492, 0x024C86049B,
+		0x02530804A4, 0x02578A04AD, 0x025A0C04B6, 0x025E8E04BF,
+		0x02651004C8, 0x02619204C1, 0x026C1404DA, 0x02689604D3,
+		0x02771804EC, 0x02739A04E5, 0x027E1C04FE, 0x027A9E04F7,
+		0x0209200410, 0x020DA20419, 0x0200240402, 0x0204A6040B,
+		0x021B280434, 0x021FAA043D, 0x02122C0426, 0x0216AE042F,
+		0x022D300458, 0x0229B20451, 0x022434044A, 0x0220B60443,
+		0x023F38047C, 0x023BBA0475, 0x02363C046E, 0x0232BE0467,
+		0x02D14005A0, 0x02D5C205A9, 0x02D84405B2, 0x02DCC605BB,
+		0x02C3480584, 0x02C7CA058D, 0x02CA4C0596, 0x02CECE059F,
+		0x02F55005E8, 0x02F1D205E1, 0x02FC5405FA, 0x02F8D605F3,
+		0x02E75805CC, 0x02E3DA05C5, 0x02EE5C05DE, 0x02EADE05D7,
+		0x0299600530, 0x029DE20539, 0x0290640522, 0x0294E6052B,
+		0x028B680514, 0x028FEA051D, 0x02826C0506, 0x0286EE050F,
+		0x02BD700578, 0x02B9F20571, 0x02B474056A, 0x02B0F60563,
+		0x02AF78055C, 0x02ABFA0555, 0x02A67C054E, 0x02A2FE0547,
+		0x03618006C0, 0x03650206C9, 0x03688406D2, 0x036C0606DB,
+		0x03738806E4, 0x03770A06ED, 0x037A8C06F6, 0x037E0E06FF,
+		0x0345900688, 0x0341120681, 0x034C94069A, 0x0348160693,
+		0x03579806AC, 0x03531A06A5, 0x035E9C06BE, 0x035A1E06B7,
+		0x0329A00650, 0x032D220659, 0x0320A40642, 0x032426064B,
+		0x033BA80674, 0x033F2A067D, 0x0332AC0666, 0x03362E066F,
+		0x030DB00618, 0x0309320611, 0x0304B4060A, 0x0300360603,
+		0x031FB8063C, 0x031B3A0635, 0x0316BC062E, 0x03123E0627,
+		0x03F1C007E0, 0x03F54207E9, 0x03F8C407F2, 0x03FC4607FB,
+		0x03E3C807C4, 0x03E74A07CD, 0x03EACC07D6, 0x03EE4E07DF,
+		0x03D5D007A8, 0x03D15207A1, 0x03DCD407BA, 0x03D85607B3,
+		0x03C7D8078C, 0x03C35A0785, 0x03CEDC079E, 0x03CA5E0797,
+		0x03B9E00770, 0x03BD620779, 0x03B0E40762, 0x03B466076B,
+		0x03ABE80754, 0x03AF6A075D, 0x03A2EC0746, 0x03A66E074F,
+		0x039DF00738, 0x0399720731, 0x0394F4072A, 0x0390760723,
+		0x038FF8071C, 0x038B7A0715, 0x0386FC070E, 0x03827E0707,
+	},
+	{64, 0x42F0E1EBA9EA3693, false}: {
+		0x0000000000000000, 0x42F0E1EBA9EA3693, 0x85E1C3D753D46D26, 0xC711223CFA3E5BB5,
+		0x493366450E42ECDF, 0x0BC387AEA7A8DA4C, 0xCCD2A5925D9681F9, 0x8E224479F47CB76A,
+		0x9266CC8A1C85D9BE, 0xD0962D61B56FEF2D, 0x17870F5D4F51B498, 0x5577EEB6E6BB820B,
+		0xDB55AACF12C73561, 0x99A54B24BB2D03F2, 0x5EB4691841135847, 0x1C4488F3E8F96ED4,
+		0x663D78FF90E185EF, 0x24CD9914390BB37C, 0xE3DCBB28C335E8C9, 0xA12C5AC36ADFDE5A,
+		0x2F0E1EBA9EA36930, 0x6DFEFF5137495FA3, 0xAAEFDD6DCD770416, 0xE81F3C86649D3285,
+		0xF45BB4758C645C51, 0xB6AB559E258E6AC2, 0x71BA77A2DFB03177, 0x334A9649765A07E4,
+		0xBD68D2308226B08E, 0xFF9833DB2BCC861D, 0x388911E7D1F2DDA8, 0x7A79F00C7818EB3B,
+		0xCC7AF1FF21C30BDE, 0x8E8A101488293D4D, 0x499B3228721766F8, 0x0B6BD3C3DBFD506B,
+		0x854997BA2F81E701, 0xC7B97651866BD192, 0x00A8546D7C558A27, 0x4258B586D5BFBCB4,
+		0x5E1C3D753D46D260, 0x1CECDC9E94ACE4F3, 0xDBFDFEA26E92BF46, 0x990D1F49C77889D5,
+		0x172F5B3033043EBF, 0x55DFBADB9AEE082C, 0x92CE98E760D05399, 0xD03E790CC93A650A,
+		0xAA478900B1228E31, 0xE8B768EB18C8B8A2, 0x2FA64AD7E2F6E317, 0x6D56AB3C4B1CD584,
+		0xE374EF45BF6062EE, 0xA1840EAE168A547D, 0x66952C92ECB40FC8, 0x2465CD79455E395B,
+		0x3821458AADA7578F, 0x7AD1A461044D611C, 0xBDC0865DFE733AA9, 0xFF3067B657990C3A,
+		0x711223CFA3E5BB50, 0x33E2C2240A0F8DC3, 0xF4F3E018F031D676, 0xB60301F359DBE0E5,
+		0xDA050215EA6C212F, 0x98F5E3FE438617BC, 0x5FE4C1C2B9B84C09, 0x1D14202910527A9A,
+		0x93366450E42ECDF0, 0xD1C685BB4DC4FB63, 0x16D7A787B7FAA0D6, 0x5427466C1E109645,
+		0x4863CE9FF6E9F891, 0x0A932F745F03CE02, 0xCD820D48A53D95B7, 0x8F72ECA30CD7A324,
+		0x0150A8DAF8AB144E, 0x43A04931514122DD, 0x84B16B0DAB7F7968, 0xC6418AE602954FFB,
+		0xBC387AEA7A8DA4C0, 0xFEC89B01D3679253, 0x39D9B93D2959C9E6, 0x7B2958D680B3FF75,
+		0xF50B1CAF74CF481F, 0xB7FBFD44DD257E8C, 0x70EADF78271B2539, 0x321A3E938EF113AA,
+		0x2E5EB66066087D7E, 0x6CAE578BCFE24BED, 0xABBF75B735DC1058, 0xE94F945C9C3626CB,
+		0x676DD025684A91A1, 0x259D31CEC1A0A732, 0xE28C13F23B9EFC87, 0xA07CF2199274CA14,
+		0x167FF3EACBAF2AF1, 0x548F120162451C62, 0x939E303D987B47D7, 0xD16ED1D631917144,
+		0x5F4C95AFC5EDC62E, 0x1DBC74446C07F0BD, 0xDAAD56789639AB08, 0x985DB7933FD39D9B,
+		0x84193F60D72AF34F, 0xC6E9DE8B7EC0C5DC, 0x01F8FCB784FE9E69, 0x43081D5C2D14A8FA,
+		0xCD2A5925D9681F90, 0x8FDAB8CE70822903, 0x48CB9AF28ABC72B6, 0x0A3B7B1923564425,
+		0x70428B155B4EAF1E, 0x32B26AFEF2A4998D, 0xF5A348C2089AC238, 0xB753A929A170F4AB,
+		0x3971ED50550C43C1, 0x7B810CBBFCE67552, 0xBC902E8706D82EE7, 0xFE60CF6CAF321874,
+		0xE224479F47CB76A0, 0xA0D4A674EE214033, 0x67C58448141F1B86, 0x253565A3BDF52D15,
+		0xAB1721DA49899A7F, 0xE9E7C031E063ACEC, 0x2EF6E20D1A5DF759, 0x6C0603E6B3B7C1CA,
+		0xF6FAE5C07D3274CD, 0xB40A042BD4D8425E, 0x731B26172EE619EB, 0x31EBC7FC870C2F78,
+		0xBFC9838573709812, 0xFD39626EDA9AAE81, 0x3A28405220A4F534, 0x78D8A1B9894EC3A7,
+		0x649C294A61B7AD73, 0x266CC8A1C85D9BE0, 0xE17DEA9D3263C055, 0xA38D0B769B89F6C6,
+		0x2DAF4F0F6FF541AC, 0x6F5FAEE4C61F773F, 0xA84E8CD83C212C8A, 0xEABE6D3395CB1A19,
+		0x90C79D3FEDD3F122, 0xD2377CD44439C7B1, 0x15265EE8BE079C04, 0x57D6BF0317EDAA97,
+		0xD9F4FB7AE3911DFD, 0x9B041A914A7B2B6E, 0x5C1538ADB04570DB, 0x1EE5D94619AF4648,
+		0x02A151B5F156289C, 0x4051B05E58BC1E0F, 0x87409262A28245BA, 0xC5B073890B687329,
+		0x4B9237F0FF14C443, 0x0962D61B56FEF2D0, 0xCE73F427ACC0A965, 0x8C8315CC052A9FF6,
+		0x3A80143F5CF17F13, 0x7870F5D4F51B4980, 0xBF61D7E80F251235, 0xFD913603A6CF24A6,
+		0x73B3727A52B393CC, 0x31439391FB59A55F, 0xF652B1AD0167FEEA, 0xB4A25046A88DC879,
+		0xA8E6D8B54074A6AD, 0xEA16395EE99E903E, 0x2D071B6213A0CB8B, 0x6FF7FA89BA4AFD18,
+		0xE1D5BEF04E364A72, 0xA3255F1BE7DC7CE1, 0x64347D271DE22754, 0x26C49CCCB40811C7,
+		0x5CBD6CC0CC10FAFC, 0x1E4D8D2B65FACC6F, 0xD95CAF179FC497DA, 0x9BAC4EFC362EA149,
+		0x158E0A85C2521623, 0x577EEB6E6BB820B0, 0x906FC95291867B05, 0xD29F28B9386C4D96,
+		0xCEDBA04AD0952342, 0x8C2B41A1797F15D1, 0x4B3A639D83414E64, 0x09CA82762AAB78F7,
+		0x87E8C60FDED7CF9D, 0xC51827E4773DF90E, 0x020905D88D03A2BB, 0x40F9E43324E99428,
+		0x2CFFE7D5975E55E2, 0x6E0F063E3EB46371, 0xA91E2402C48A38C4, 0xEBEEC5E96D600E57,
+		0x65CC8190991CB93D, 0x273C607B30F68FAE, 0xE02D4247CAC8D41B, 0xA2DDA3AC6322E288,
+		0xBE992B5F8BDB8C5C, 0xFC69CAB42231BACF, 0x3B78E888D80FE17A, 0x7988096371E5D7E9,
+		0xF7AA4D1A85996083, 0xB55AACF12C735610, 0x724B8ECDD64D0DA5, 0x30BB6F267FA73B36,
+		0x4AC29F2A07BFD00D, 0x08327EC1AE55E69E, 0xCF235CFD546BBD2B, 0x8DD3BD16FD818BB8,
+		0x03F1F96F09FD3CD2, 0x41011884A0170A41, 0x86103AB85A2951F4, 0xC4E0DB53F3C36767,
+		0xD8A453A01B3A09B3, 0x9A54B24BB2D03F20, 0x5D45907748EE6495, 0x1FB5719CE1045206,
+		0x919735E51578E56C, 0xD367D40EBC92D3FF, 0x1476F63246AC884A, 0x568617D9EF46BED9,
+		0xE085162AB69D5E3C, 0xA275F7C11F7768AF, 0x6564D5FDE549331A, 0x279434164CA30589,
+		0xA9B6706FB8DFB2E3, 0xEB46918411358470, 0x2C57B3B8EB0BDFC5, 0x6EA7525342E1E956,
+		0x72E3DAA0AA188782, 0x30133B4B03F2B111, 0xF7021977F9CCEAA4, 0xB5F2F89C5026DC37,
+		0x3BD0BCE5A45A6B5D, 0x79205D0E0DB05DCE, 0xBE317F32F78E067B, 0xFCC19ED95E6430E8,
+		0x86B86ED5267CDBD3, 0xC4488F3E8F96ED40, 0x0359AD0275A8B6F5, 0x41A94CE9DC428066,
+		0xCF8B0890283E370C, 0x8D7BE97B81D4019F, 0x4A6ACB477BEA5A2A, 0x089A2AACD2006CB9,
+		0x14DEA25F3AF9026D, 0x562E43B4931334FE, 0x913F6188692D6F4B, 0xD3CF8063C0C759D8,
+		0x5DEDC41A34BBEEB2, 0x1F1D25F19D51D821, 0xD80C07CD676F8394, 0x9AFCE626CE85B507,
+	},
+	{64, 0x1B, true}: {
+		0x0000000000000000, 0x01B0000000000000, 0x0360000000000000, 0x02D0000000000000,
+		0x06C0000000000000, 0x0770000000000000, 0x05A0000000000000, 0x0410000000000000,
+		0x0D80000000000000, 0x0C30000000000000, 0x0EE0000000000000, 0x0F50000000000000,
+		0x0B40000000000000, 0x0AF0000000000000, 0x0820000000000000, 0x0990000000000000,
+		0x1B00000000000000, 0x1AB0000000000000, 0x1860000000000000, 0x19D0000000000000,
+		0x1DC0000000000000, 0x1C70000000000000, 0x1EA0000000000000, 0x1F10000000000000,
+		0x1680000000000000, 0x1730000000000000, 0x15E0000000000000, 0x1450000000000000,
+		0x1040000000000000, 0x11F0000000000000, 0x1320000000000000, 0x1290000000000000,
+		0x3600000000000000, 0x37B0000000000000, 0x3560000000000000, 0x34D0000000000000,
+		0x30C0000000000000, 0x3170000000000000, 0x33A0000000000000, 0x3210000000000000,
+		0x3B80000000000000, 0x3A30000000000000, 0x38E0000000000000, 0x3950000000000000,
+		0x3D40000000000000, 0x3CF0000000000000, 0x3E20000000000000, 0x3F90000000000000,
+		0x2D00000000000000, 0x2CB0000000000000, 0x2E60000000000000, 0x2FD0000000000000,
+		0x2BC0000000000000, 0x2A70000000000000, 0x28A0000000000000, 0x2910000000000000,
+		0x2080000000000000, 0x2130000000000000, 0x23E0000000000000, 0x2250000000000000,
+		0x2640000000000000, 0x27F0000000000000, 0x2520000000000000, 0x2490000000000000,
+		0x6C00000000000000, 0x6DB0000000000000, 0x6F60000000000000, 0x6ED0000000000000,
+		0x6AC0000000000000, 0x6B70000000000000, 0x69A0000000000000, 0x6810000000000000,
+		0x6180000000000000, 0x6030000000000000, 0x62E0000000000000, 0x6350000000000000,
+		0x6740000000000000, 0x66F0000000000000, 0x6420000000000000, 0x6590000000000000,
+		0x7700000000000000, 0x76B0000000000000, 0x7460000000000000, 0x75D0000000000000,
+		0x71C0000000000000, 0x7070000000000000, 0x72A0000000000000, 0x7310000000000000,
+		0x7A80000000000000, 0x7B30000000000000, 0x79E0000000000000, 0x7850000000000000,
+		0x7C40000000000000, 0x7DF0000000000000, 0x7F20000000000000, 0x7E90000000000000,
+		0x5A00000000000000, 0x5BB0000000000000, 0x5960000000000000, 0x58D0000000000000,
+		0x5CC0000000000000, 0x5D70000000000000, 0x5FA0000000000000, 0x5E10000000000000,
+		0x5780000000000000, 0x5630000000000000, 0x54E0000000000000, 0x5550000000000000,
+		0x5140000000000000, 0x50F0000000000000, 0x5220000000000000, 0x5390000000000000,
+		0x4100000000000000, 0x40B0000000000000, 0x4260000000000000, 0x43D0000000000000,
+		0x47C0000000000000, 0x4670000000000000, 0x44A0000000000000, 0x4510000000000000,
+		0x4C80000000000000, 0x4D30000000000000, 0x4FE0000000000000, 0x4E50000000000000,
+		0x4A40000000000000, 0x4BF0000000000000, 0x4920000000000000, 0x4890000000000000,
+		0xD800000000000000, 0xD9B0000000000000, 0xDB60000000000000, 0xDAD0000000000000,
+		0xDEC0000000000000, 0xDF70000000000000, 0xDDA0000000000000, 0xDC10000000000000,
+		0xD580000000000000, 0xD430000000000000, 0xD6E0000000000000, 0xD750000000000000,
+		0xD340000000000000, 0xD2F0000000000000, 0xD020000000000000, 0xD190000000000000,
+		0xC300000000000000, 0xC2B0000000000000, 0xC060000000000000, 0xC1D0000000000000,
+		0xC5C0000000000000, 0xC470000000000000, 0xC6A0000000000000, 0xC710000000000000,
+		0xCE80000000000000, 0xCF30000000000000, 0xCDE0000000000000, 0xCC50000000000000,
+		0xC840000000000000, 0xC9F0000000000000, 0xCB20000000000000, 0xCA90000000000000,
+		0xEE00000000000000, 0xEFB0000000000000, 0xED60000000000000, 0xECD0000000000000,
+		0xE8C0000000000000, 0xE970000000000000, 0xEBA0000000000000, 0xEA10000000000000,
+		0xE380000000000000, 0xE230000000000000, 0xE0E0000000000000, 0xE150000000000000,
+		0xE540000000000000, 0xE4F0000000000000, 0xE620000000000000, 0xE790000000000000,
+		0xF500000000000000, 0xF4B0000000000000, 0xF660000000000000, 0xF7D0000000000000,
+		0xF3C0000000000000, 0xF270000000000000, 0xF0A0000000000000, 0xF110000000000000,
+		0xF880000000000000, 0xF930000000000000, 0xFBE0000000000000, 0xFA50000000000000,
+		0xFE40000000000000, 0xFFF0000000000000, 0xFD20000000000000, 0xFC90000000000000,
+		0xB400000000000000, 0xB5B0000000000000, 0xB760000000000000, 0xB6D0000000000000,
+		0xB2C0000000000000, 0xB370000000000000, 0xB1A0000000000000, 0xB010000000000000,
+		0xB980000000000000, 0xB830000000000000, 0xBAE0000000000000, 0xBB50000000000000,
+		0xBF40000000000000, 0xBEF0000000000000, 0xBC20000000000000, 0xBD90000000000000,
+		0xAF00000000000000, 0xAEB0000000000000, 0xAC60000000000000, 0xADD0000000000000,
+		0xA9C0000000000000, 0xA870000000000000, 0xAAA0000000000000, 0xAB10000000000000,
+		0xA280000000000000, 0xA330000000000000, 0xA1E0000000000000, 0xA050000000000000,
+		0xA440000000000000, 0xA5F0000000000000, 0xA720000000000000, 0xA690000000000000,
+		0x8200000000000000, 0x83B0000000000000, 0x8160000000000000, 0x80D0000000000000,
+		0x84C0000000000000, 0x8570000000000000, 0x87A0000000000000, 0x8610000000000000,
+		0x8F80000000000000, 0x8E30000000000000, 0x8CE0000000000000, 0x8D50000000000000,
+		0x8940000000000000, 0x88F0000000000000, 0x8A20000000000000, 0x8B90000000000000,
+		0x9900000000000000, 0x98B0000000000000, 0x9A60000000000000, 0x9BD0000000000000,
+		0x9FC0000000000000, 0x9E70000000000000, 0x9CA0000000000000, 0x9D10000000000000,
+		0x9480000000000000, 0x9530000000000000, 0x97E0000000000000, 0x9650000000000000,
+		0x9240000000000000, 0x93F0000000000000, 0x9120000000000000, 0x9090000000000000,
+	},
+	{64, 0x259C84CBA6426349, true}: {
+		0x0000000000000000, 0x0809E8A2969451E9, 0x1013D1452D28A3D2, 0x181A39E7BBBCF23B,
+		0x2027A28A5A5147A4, 0x282E4A28CCC5164D, 0x303473CF7779E476, 0x383D9B6DE1EDB59F,
+		0x404F4514B4A28F48, 0x4846ADB62236DEA1, 0x505C9451998A2C9A, 0x58557CF30F1E7D73,
+		0x6068E79EEEF3C8EC, 0x68610F3C78679905, 0x707B36DBC3DB6B3E, 0x7872DE79554F3AD7,
+		0x809E8A2969451E90, 0x8897628BFFD14F79, 0x908D5B6C446DBD42, 0x9884B3CED2F9ECAB,
+		0xA0B928A333145934, 0xA8B0C001A58008DD, 0xB0AAF9E61E3CFAE6, 0xB8A3114488A8AB0F,
+		0xC0D1CF3DDDE791D8, 0xC8D8279F4B73C031, 0xD0C21E78F0CF320A, 0xD8CBF6DA665B63E3,
+		0xE0F66DB787B6D67C, 0xE8FF851511228795, 0xF0E5BCF2AA9E75AE, 0xF8EC54503C0A2447,
+		0x24B1909974C84E69, 0x2CB8783BE25C1F80, 0x34A241DC59E0EDBB, 0x3CABA97ECF74BC52,
+		0x049632132E9909CD, 0x0C9FDAB1B80D5824, 0x1485E35603B1AA1F, 0x1C8C0BF49525FBF6,
+		0x64FED58DC06AC121, 0x6CF73D2F56FE90C8, 0x74ED04C8ED4262F3, 0x7CE4EC6A7BD6331A,
+		0x44D977079A3B8685, 0x4CD09FA50CAFD76C, 0x54CAA642B7132557, 0x5CC34EE0218774BE,
+		0xA42F1AB01D8D50F9, 0xAC26F2128B190110, 0xB43CCBF530A5F32B, 0xBC352357A631A2C2,
+		0x8408B83A47DC175D, 0x8C015098D14846B4, 0x941B697F6AF4B48F, 0x9C1281DDFC60E566,
+		0xE4605FA4A92FDFB1, 0xEC69B7063FBB8E58, 0xF4738EE184077C63, 0xFC7A664312932D8A,
+		0xC447FD2EF37E9815, 0xCC4E158C65EAC9FC, 0xD4542C6BDE563BC7, 0xDC5DC4C948C26A2E,
+		0x49632132E9909CD2, 0x416AC9907F04CD3B, 0x5970F077C4B83F00, 0x517918D5522C6EE9,
+		0x694483B8B3C1DB76, 0x614D6B1A25558A9F, 0x795752FD9EE978A4, 0x715EBA5F087D294D,
+		0x092C64265D32139A, 0x01258C84CBA64273, 0x193FB563701AB048, 0x11365DC1E68EE1A1,
+		0x290BC6AC0763543E, 0x21022E0E91F705D7, 0x391817E92A4BF7EC, 0x3111FF4BBCDFA605,
+		0xC9FDAB1B80D58242, 0xC1F443B91641D3AB, 0xD9EE7A5EADFD2190, 0xD1E792FC3B697079,
+		0xE9DA0991DA84C5E6, 0xE1D3E1334C10940F, 0xF9C9D8D4F7AC6634, 0xF1C03076613837DD,
+		0x89B2EE0F34770D0A, 0x81BB06ADA2E35CE3, 0x99A13F4A195FAED8, 0x91A8D7E88FCBFF31,
+		0xA9954C856E264AAE, 0xA19CA427F8B21B47, 0xB9869DC0430EE97C, 0xB18F7562D59AB895,
+		0x6DD2B1AB9D58D2BB, 0x65DB59090BCC8352, 0x7DC160EEB0707169, 0x75C8884C26E42080,
+		0x4DF51321C709951F, 0x45FCFB83519DC4F6, 0x5DE6C264EA2136CD, 0x55EF2AC67CB56724,
+		0x2D9DF4BF29FA5DF3, 0x25941C1DBF6E0C1A, 0x3D8E25FA04D2FE21, 0x3587CD589246AFC8,
+		0x0DBA563573AB1A57, 0x05B3BE97E53F4BBE, 0x1DA987705E83B985, 0x15A06FD2C817E86C,
+		0xED4C3B82F41DCC2B, 0xE545D32062899DC2, 0xFD5FEAC7D9356FF9, 0xF55602654FA13E10,
+		0xCD6B9908AE4C8B8F, 0xC56271AA38D8DA66, 0xDD78484D8364285D, 0xD571A0EF15F079B4,
+		0xAD037E9640BF4363, 0xA50A9634D62B128A, 0xBD10AFD36D97E0B1, 0xB5194771FB03B158,
+		0x8D24DC1C1AEE04C7, 0x852D34BE8C7A552E, 0x9D370D5937C6A715, 0x953EE5FBA152F6FC,
+		0x92C64265D32139A4, 0x9ACFAAC745B5684D, 0x82D59320FE099A76, 0x8ADC7B82689DCB9F,
+		0xB2E1E0EF89707E00, 0xBAE8084D1FE42FE9, 0xA2F231AAA458DDD2, 0xAAFBD90832CC8C3B,
+		0xD28907716783B6EC, 0xDA80EFD3F117E705, 0xC29AD6344AAB153E, 0xCA933E96DC3F44D7,
+		0xF2AEA5FB3DD2F148, 0xFAA74D59AB46A0A1, 0xE2BD74BE10FA529A, 0xEAB49C1C866E0373,
+		0x1258C84CBA642734, 0x1A5120EE2CF076DD, 0x024B1909974C84E6, 0x0A42F1AB01D8D50F,
+		0x327F6AC6E0356090, 0x3A76826476A13179, 0x226CBB83CD1DC342, 0x2A6553215B8992AB,
+		0x52178D580EC6A87C, 0x5A1E65FA9852F995, 0x42045C1D23EE0BAE, 0x4A0DB4BFB57A5A47,
+		0x72302FD25497EFD8, 0x7A39C770C203BE31, 0x6223FE9779BF4C0A, 0x6A2A1635EF2B1DE3,
+		0xB677D2FCA7E977CD, 0xBE7E3A5E317D2624, 0xA66403B98AC1D41F, 0xAE6DEB1B1C5585F6,
+		0x96507076FDB83069, 0x9E5998D46B2C6180, 0x8643A133D09093BB, 0x8E4A49914604C252,
+		0xF63897E8134BF885, 0xFE317F4A85DFA96C, 0xE62B46AD3E635B57, 0xEE22AE0FA8F70ABE,
+		0xD61F3562491ABF21, 0xDE16DDC0DF8EEEC8, 0xC60CE42764321CF3, 0xCE050C85F2A64D1A,
+		0x36E958D5CEAC695D, 0x3EE0B077583838B4, 0x26FA8990E384CA8F, 0x2EF3613275109B66,
+		0x16CEFA5F94FD2EF9, 0x1EC712FD02697F10, 0x06DD2B1AB9D58D2B, 0x0ED4C3B82F41DCC2,
+		0x76A61DC17A0EE615, 0x7EAFF563EC9AB7FC, 0x66B5CC84572645C7, 0x6EBC2426C1B2142E,
+		0x5681BF4B205FA1B1, 0x5E8857E9B6CBF058, 0x46926E0E0D770263, 0x4E9B86AC9BE3538A,
+		0xDBA563573AB1A576, 0xD3AC8BF5AC25F49F, 0xCBB6B212179906A4, 0xC3BF5AB0810D574D,
+		0xFB82C1DD60E0E2D2, 0xF38B297FF674B33B, 0xEB9110984DC84100, 0xE398F83ADB5C10E9,
+		0x9BEA26438E132A3E, 0x93E3CEE118877BD7, 0x8BF9F706A33B89EC, 0x83F01FA435AFD805,
+		0xBBCD84C9D4426D9A, 0xB3C46C6B42D63C73, 0xABDE558CF96ACE48, 0xA3D7BD2E6FFE9FA1,
+		0x5B3BE97E53F4BBE6, 0x533201DCC560EA0F, 0x4B28383B7EDC1834, 0x4321D099E84849DD,
+		0x7B1C4BF409A5FC42, 0x7315A3569F31ADAB, 0x6B0F9AB1248D5F90, 0x63067213B2190E79,
+		0x1B74AC6AE75634AE, 0x137D44C871C26547, 0x0B677D2FCA7E977C, 0x036E958D5CEAC695,
+		0x3B530EE0BD07730A, 0x335AE6422B9322E3, 0x2B40DFA5902FD0D8, 0x2349370706BB8131,
+		0xFF14F3CE4E79EB1F, 0xF71D1B6CD8EDBAF6, 0xEF07228B635148CD, 0xE70ECA29F5C51924,
+		0xDF3351441428ACBB, 0xD73AB9E682BCFD52, 0xCF20800139000F69, 0xC72968A3AF945E80,
+		0xBF5BB6DAFADB6457, 0xB7525E786C4F35BE, 0xAF48679FD7F3C785, 0xA7418F3D4167966C,
+		0x9F7C1450A08A23F3, 0x9775FCF2361E721A, 0x8F6FC5158DA28021, 0x87662DB71B36D1C8,
+		0x7F8A79E7273CF58F, 0x77839145B1A8A466, 0x6F99A8A20A14565D, 0x679040009C8007B4,
+		0x5FADDB6D7D6DB22B, 0x57A433CFEBF9E3C2, 0x4FBE0A28504511F9, 0x47B7E28AC6D14010,
+		0x3FC53CF3939E7AC7, 0x37CCD451050A2B2E, 0x2FD6EDB6BEB6D915, 0x27DF0514282288FC,
+		0x1FE29E79C9CF3D63, 0x17EB76DB5F5B6C8A, 0x0FF14F3CE4E79EB1, 0x07F8A79E7273CF58,
+	},
+	{64, 0xAD93D23594C93659, true}: {
+		0x0000000000000000, 0x7F6EF0C830358979, 0xFEDDE190606B12F2, 0x81B31158505E9B8B,
+		0xC962E5739841B68F, 0xB60C15BBA8743FF6, 0x37BF04E3F82AA47D, 0x48D1F42BC81F2D04,
+		0xA61CECB46814FE75, 0xD9721C7C5821770C, 0x58C10D24087FEC87, 0x27AFFDEC384A65FE,
+		0x6F7E09C7F05548FA, 0x1010F90FC060C183, 0x91A3E857903E5A08, 0xEECD189FA00BD371,
+		0x78E0FF3B88BE6F81, 0x078E0FF3B88BE6F8, 0x863D1EABE8D57D73, 0xF953EE63D8E0F40A,
+		0xB1821A4810FFD90E, 0xCEECEA8020CA5077, 0x4F5FFBD87094CBFC, 0x30310B1040A14285,
+		0xDEFC138FE0AA91F4, 0xA192E347D09F188D, 0x2021F21F80C18306, 0x5F4F02D7B0F40A7F,
+		0x179EF6FC78EB277B, 0x68F0063448DEAE02, 0xE943176C18803589, 0x962DE7A428B5BCF0,
+		0xF1C1FE77117CDF02, 0x8EAF0EBF2149567B, 0x0F1C1FE77117CDF0, 0x7072EF2F41224489,
+		0x38A31B04893D698D, 0x47CDEBCCB908E0F4, 0xC67EFA94E9567B7F, 0xB9100A5CD963F206,
+		0x57DD12C379682177, 0x28B3E20B495DA80E, 0xA900F35319033385, 0xD66E039B2936BAFC,
+		0x9EBFF7B0E12997F8, 0xE1D10778D11C1E81, 0x606216208142850A, 0x1F0CE6E8B1770C73,
+		0x8921014C99C2B083, 0xF64FF184A9F739FA, 0x77FCE0DCF9A9A271, 0x08921014C99C2B08,
+		0x4043E43F0183060C, 0x3F2D14F731B68F75, 0xBE9E05AF61E814FE, 0xC1F0F56751DD9D87,
+		0x2F3DEDF8F1D64EF6, 0x50531D30C1E3C78F, 0xD1E00C6891BD5C04, 0xAE8EFCA0A188D57D,
+		0xE65F088B6997F879, 0x9931F84359A27100, 0x1882E91B09FCEA8B, 0x67EC19D339C963F2,
+		0xD75ADABD7A6E2D6F, 0xA8342A754A5BA416, 0x29873B2D1A053F9D, 0x56E9CBE52A30B6E4,
+		0x1E383FCEE22F9BE0, 0x6156CF06D21A1299, 0xE0E5DE5E82448912, 0x9F8B2E96B271006B,
+		0x71463609127AD31A, 0x0E28C6C1224F5A63, 0x8F9BD7997211C1E8, 0xF0F5275142244891,
+		0xB824D37A8A3B6595, 0xC74A23B2BA0EECEC, 0x46F932EAEA507767, 0x3997C222DA65FE1E,
+		0xAFBA2586F2D042EE, 0xD0D4D54EC2E5CB97, 0x5167C41692BB501C, 0x2E0934DEA28ED965,
+		0x66D8C0F56A91F461, 0x19B6303D5AA47D18, 0x980521650AFAE693, 0xE76BD1AD3ACF6FEA,
+		0x09A6C9329AC4BC9B, 0x76C839FAAAF135E2, 0xF77B28A2FAAFAE69, 0x8815D86ACA9A2710,
+		0xC0C42C4102850A14, 0xBFAADC8932B0836D, 0x3E19CDD162EE18E6, 0x41773D1952DB919F,
+		0x269B24CA6B12F26D, 0x59F5D4025B277B14, 0xD846C55A0B79E09F, 0xA72835923B4C69E6,
+		0xEFF9C1B9F35344E2, 0x90973171C366CD9B, 0x1124202993385610, 0x6E4AD0E1A30DDF69,
+		0x8087C87E03060C18, 0xFFE938B633338561, 0x7E5A29EE636D1EEA, 0x0134D92653589793,
+		0x49E52D0D9B47BA97, 0x368BDDC5AB7233EE, 0xB738CC9DFB2CA865, 0xC8563C55CB19211C,
+		0x5E7BDBF1E3AC9DEC, 0x21152B39D3991495, 0xA0A63A6183C78F1E, 0xDFC8CAA9B3F20667,
+		0x97193E827BED2B63, 0xE877CE4A4BD8A21A, 0x69C4DF121B863991, 0x16AA2FDA2BB3B0E8,
+		0xF86737458BB86399, 0x8709C78DBB8DEAE0, 0x06BAD6D5EBD3716B, 0x79D4261DDBE6F812,
+		0x3105D23613F9D516, 0x4E6B22FE23CC5C6F, 0xCFD833A67392C7E4, 0xB0B6C36E43A74E9D,
+		0x9A6C9329AC4BC9B5, 0xE50263E19C7E40CC, 0x64B172B9CC20DB47, 0x1BDF8271FC15523E,
+		0x530E765A340A7F3A, 0x2C608692043FF643, 0xADD397CA54616DC8, 0xD2BD67026454E4B1,
+		0x3C707F9DC45F37C0, 0x431E8F55F46ABEB9, 0xC2AD9E0DA4342532, 0xBDC36EC59401AC4B,
+		0xF5129AEE5C1E814F, 0x8A7C6A266C2B0836, 0x0BCF7B7E3C7593BD, 0x74A18BB60C401AC4,
+		0xE28C6C1224F5A634, 0x9DE29CDA14C02F4D, 0x1C518D82449EB4C6, 0x633F7D4A74AB3DBF,
+		0x2BEE8961BCB410BB, 0x548079A98C8199C2, 0xD53368F1DCDF0249, 0xAA5D9839ECEA8B30,
+		0x449080A64CE15841, 0x3BFE706E7CD4D138, 0xBA4D61362C8A4AB3, 0xC52391FE1CBFC3CA,
+		0x8DF265D5D4A0EECE, 0xF29C951DE49567B7, 0x732F8445B4CBFC3C, 0x0C41748D84FE7545,
+		0x6BAD6D5EBD3716B7, 0x14C39D968D029FCE, 0x95708CCEDD5C0445, 0xEA1E7C06ED698D3C,
+		0xA2CF882D2576A038, 0xDDA178E515432941, 0x5C1269BD451DB2CA, 0x237C997575283BB3,
+		0xCDB181EAD523E8C2, 0xB2DF7122E51661BB, 0x336C607AB548FA30, 0x4C0290B2857D7349,
+		0x04D364994D625E4D, 0x7BBD94517D57D734, 0xFA0E85092D094CBF, 0x856075C11D3CC5C6,
+		0x134D926535897936, 0x6C2362AD05BCF04F, 0xED9073F555E26BC4, 0x92FE833D65D7E2BD,
+		0xDA2F7716ADC8CFB9, 0xA54187DE9DFD46C0, 0x24F29686CDA3DD4B, 0x5B9C664EFD965432,
+		0xB5517ED15D9D8743, 0xCA3F8E196DA80E3A, 0x4B8C9F413DF695B1, 0x34E26F890DC31CC8,
+		0x7C339BA2C5DC31CC, 0x035D6B6AF5E9B8B5, 0x82EE7A32A5B7233E, 0xFD808AFA9582AA47,
+		0x4D364994D625E4DA, 0x3258B95CE6106DA3, 0xB3EBA804B64EF628, 0xCC8558CC867B7F51,
+		0x8454ACE74E645255, 0xFB3A5C2F7E51DB2C, 0x7A894D772E0F40A7, 0x05E7BDBF1E3AC9DE,
+		0xEB2AA520BE311AAF, 0x944455E88E0493D6, 0x15F744B0DE5A085D, 0x6A99B478EE6F8124,
+		0x224840532670AC20, 0x5D26B09B16452559, 0xDC95A1C3461BBED2, 0xA3FB510B762E37AB,
+		0x35D6B6AF5E9B8B5B, 0x4AB846676EAE0222, 0xCB0B573F3EF099A9, 0xB465A7F70EC510D0,
+		0xFCB453DCC6DA3DD4, 0x83DAA314F6EFB4AD, 0x0269B24CA6B12F26, 0x7D0742849684A65F,
+		0x93CA5A1B368F752E, 0xECA4AAD306BAFC57, 0x6D17BB8B56E467DC, 0x12794B4366D1EEA5,
+		0x5AA8BF68AECEC3A1, 0x25C64FA09EFB4AD8, 0xA4755EF8CEA5D153, 0xDB1BAE30FE90582A,
+		0xBCF7B7E3C7593BD8, 0xC399472BF76CB2A1, 0x422A5673A732292A, 0x3D44A6BB9707A053,
+		0x759552905F188D57, 0x0AFBA2586F2D042E, 0x8B48B3003F739FA5, 0xF42643C80F4616DC,
+		0x1AEB5B57AF4DC5AD, 0x6585AB9F9F784CD4, 0xE436BAC7CF26D75F, 0x9B584A0FFF135E26,
+		0xD389BE24370C7322, 0xACE74EEC0739FA5B, 0x2D545FB4576761D0, 0x523AAF7C6752E8A9,
+		0xC41748D84FE75459, 0xBB79B8107FD2DD20, 0x3ACAA9482F8C46AB, 0x45A459801FB9CFD2,
+		0x0D75ADABD7A6E2D6, 0x721B5D63E7936BAF, 0xF3A84C3BB7CDF024, 0x8CC6BCF387F8795D,
+		0x620BA46C27F3AA2C, 0x1D6554A417C62355, 0x9CD645FC4798B8DE, 0xE3B8B53477AD31A7,
+		0xAB69411FBFB21CA3, 0xD407B1D78F8795DA, 0x55B4A08FDFD90E51, 0x2ADA5047EFEC8728,
+	},
+	{64, 0xAD93D23594C935A9, true}: {
+		0x0000000000000000, 0x7AD870C830358979, 0xF5B0E190606B12F2, 0x8F689158505E9B8B,
+		0xC038E5739841B68F, 0xBAE095BBA8743FF6, 0x358804E3F82AA47D, 0x4F50742BC81F2D04,
+		0xAB28ECB46814FE75, 0xD1F09C7C5821770C, 0x5E980D24087FEC87, 0x24407DEC384A65FE,
+		0x6B1009C7F05548FA, 0x11C8790FC060C183, 0x9EA0E857903E5A08, 0xE478989FA00BD371,
+		0x7D08FF3B88BE6F81, 0x07D08FF3B88BE6F8, 0x88B81EABE8D57D73, 0xF2606E63D8E0F40A,
+		0xBD301A4810FFD90E, 0xC7E86A8020CA5077, 0x4880FBD87094CBFC, 0x32588B1040A14285,
+		0xD620138FE0AA91F4, 0xACF86347D09F188D, 0x2390F21F80C18306, 0x594882D7B0F40A7F,
+		0x1618F6FC78EB277B, 0x6CC0863448DEAE02, 0xE3A8176C18803589, 0x997067A428B5BCF0,
+		0xFA11FE77117CDF02, 0x80C98EBF2149567B, 0x0FA11FE77117CDF0, 0x75796F2F41224489,
+		0x3A291B04893D698D, 0x40F16BCCB908E0F4, 0xCF99FA94E9567B7F, 0xB5418A5CD963F206,
+		0x513912C379682177, 0x2BE1620B495DA80E, 0xA489F35319033385, 0xDE51839B2936BAFC,
+		0x9101F7B0E12997F8, 0xEBD98778D11C1E81, 0x64B116208142850A, 0x1E6966E8B1770C73,
+		0x8719014C99C2B083, 0xFDC17184A9F739FA, 0x72A9E0DCF9A9A271, 0x08719014C99C2B08,
+		0x4721E43F0183060C, 0x3DF994F731B68F75, 0xB29105AF61E814FE, 0xC849756751DD9D87,
+		0x2C31EDF8F1D64EF6, 0x56E99D30C1E3C78F, 0xD9810C6891BD5C04, 0xA3597CA0A188D57D,
+		0xEC09088B6997F879, 0x96D1784359A27100, 0x19B9E91B09FCEA8B, 0x636199D339C963F2,
+		0xDF7ADABD7A6E2D6F, 0xA5A2AA754A5BA416, 0x2ACA3B2D1A053F9D, 0x50124BE52A30B6E4,
+		0x1F423FCEE22F9BE0, 0x659A4F06D21A1299, 0xEAF2DE5E82448912, 0x902AAE96B271006B,
+		0x74523609127AD31A, 0x0E8A46C1224F5A63, 0x81E2D7997211C1E8, 0xFB3AA75142244891,
+		0xB46AD37A8A3B6595, 0xCEB2A3B2BA0EECEC, 0x41DA32EAEA507767, 0x3B024222DA65FE1E,
+		0xA2722586F2D042EE, 0xD8AA554EC2E5CB97, 0x57C2C41692BB501C, 0x2D1AB4DEA28ED965,
+		0x624AC0F56A91F461, 0x1892B03D5AA47D18, 0x97FA21650AFAE693, 0xED2251AD3ACF6FEA,
+		0x095AC9329AC4BC9B, 0x7382B9FAAAF135E2, 0xFCEA28A2FAAFAE69, 0x8632586ACA9A2710,
+		0xC9622C4102850A14, 0xB3BA5C8932B0836D, 0x3CD2CDD162EE18E6, 0x460ABD1952DB919F,
+		0x256B24CA6B12F26D, 0x5FB354025B277B14, 0xD0DBC55A0B79E09F, 0xAA03B5923B4C69E6,
+		0xE553C1B9F35344E2, 0x9F8BB171C366CD9B, 0x10E3202993385610, 0x6A3B50E1A30DDF69,
+		0x8E43C87E03060C18, 0xF49BB8B633338561, 0x7BF329EE636D1EEA, 0x012B592653589793,
+		0x4E7B2D0D9B47BA97, 0x34A35DC5AB7233EE, 0xBBCBCC9DFB2CA865, 0xC113BC55CB19211C,
+		0x5863DBF1E3AC9DEC, 0x22BBAB39D3991495, 0xADD33A6183C78F1E, 0xD70B4AA9B3F20667,
+		0x985B3E827BED2B63, 0xE2834E4A4BD8A21A, 0x6DEBDF121B863991, 0x1733AFDA2BB3B0E8,
+		0xF34B37458BB86399, 0x8993478DBB8DEAE0, 0x06FBD6D5EBD3716B, 0x7C23A61DDBE6F812,
+		0x3373D23613F9D516, 0x49ABA2FE23CC5C6F, 0xC6C333A67392C7E4, 0xBC1B436E43A74E9D,
+		0x95AC9329AC4BC9B5, 0xEF74E3E19C7E40CC, 0x601C72B9CC20DB47, 0x1AC40271FC15523E,
+		0x5594765A340A7F3A, 0x2F4C0692043FF643, 0xA02497CA54616DC8, 0xDAFCE7026454E4B1,
+		0x3E847F9DC45F37C0, 0x445C0F55F46ABEB9, 0xCB349E0DA4342532, 0xB1ECEEC59401AC4B,
+		0xFEBC9AEE5C1E814F, 0x8464EA266C2B0836, 0x0B0C7B7E3C7593BD, 0x71D40BB60C401AC4,
+		0xE8A46C1224F5A634, 0x927C1CDA14C02F4D, 0x1D148D82449EB4C6, 0x67CCFD4A74AB3DBF,
+		0x289C8961BCB410BB, 0x5244F9A98C8199C2, 0xDD2C68F1DCDF0249, 0xA7F41839ECEA8B30,
+		0x438C80A64CE15841, 0x3954F06E7CD4D138, 0xB63C61362C8A4AB3, 0xCCE411FE1CBFC3CA,
+		0x83B465D5D4A0EECE, 0xF96C151DE49567B7, 0x76048445B4CBFC3C, 0x0CDCF48D84FE7545,
+		0x6FBD6D5EBD3716B7, 0x15651D968D029FCE, 0x9A0D8CCEDD5C0445, 0xE0D5FC06ED698D3C,
+		0xAF85882D2576A038, 0xD55DF8E515432941, 0x5A3569BD451DB2CA, 0x20ED197575283BB3,
+		0xC49581EAD523E8C2, 0xBE4DF122E51661BB, 0x3125607AB548FA30, 0x4BFD10B2857D7349,
+		0x04AD64994D625E4D, 0x7E7514517D57D734, 0xF11D85092D094CBF, 0x8BC5F5C11D3CC5C6,
+		0x12B5926535897936, 0x686DE2AD05BCF04F, 0xE70573F555E26BC4, 0x9DDD033D65D7E2BD,
+		0xD28D7716ADC8CFB9, 0xA85507DE9DFD46C0, 0x273D9686CDA3DD4B, 0x5DE5E64EFD965432,
+		0xB99D7ED15D9D8743, 0xC3450E196DA80E3A, 0x4C2D9F413DF695B1, 0x36F5EF890DC31CC8,
+		0x79A59BA2C5DC31CC, 0x037DEB6AF5E9B8B5, 0x8C157A32A5B7233E, 0xF6CD0AFA9582AA47,
+		0x4AD64994D625E4DA, 0x300E395CE6106DA3, 0xBF66A804B64EF628, 0xC5BED8CC867B7F51,
+		0x8AEEACE74E645255, 0xF036DC2F7E51DB2C, 0x7F5E4D772E0F40A7, 0x05863DBF1E3AC9DE,
+		0xE1FEA520BE311AAF, 0x9B26D5E88E0493D6, 0x144E44B0DE5A085D, 0x6E963478EE6F8124,
+		0x21C640532670AC20, 0x5B1E309B16452559, 0xD476A1C3461BBED2, 0xAEAED10B762E37AB,
+		0x37DEB6AF5E9B8B5B, 0x4D06C6676EAE0222, 0xC26E573F3EF099A9, 0xB8B627F70EC510D0,
+		0xF7E653DCC6DA3DD4, 0x8D3E2314F6EFB4AD, 0x0256B24CA6B12F26, 0x788EC2849684A65F,
+		0x9CF65A1B368F752E, 0xE62E2AD306BAFC57, 0x6946BB8B56E467DC, 0x139ECB4366D1EEA5,
+		0x5CCEBF68AECEC3A1, 0x2616CFA09EFB4AD8, 0xA97E5EF8CEA5D153, 0xD3A62E30FE90582A,
+		0xB0C7B7E3C7593BD8, 0xCA1FC72BF76CB2A1, 0x45775673A732292A, 0x3FAF26BB9707A053,
+		0x70FF52905F188D57, 0x0A2722586F2D042E, 0x854FB3003F739FA5, 0xFF97C3C80F4616DC,
+		0x1BEF5B57AF4DC5AD, 0x61372B9F9F784CD4, 0xEE5FBAC7CF26D75F, 0x9487CA0FFF135E26,
+		0xDBD7BE24370C7322, 0xA10FCEEC0739FA5B, 0x2E675FB4576761D0, 0x54BF2F7C6752E8A9,
+		0xCDCF48D84FE75459, 0xB71738107FD2DD20, 0x387FA9482F8C46AB, 0x42A7D9801FB9CFD2,
+		0x0DF7ADABD7A6E2D6, 0x772FDD63E7936BAF, 0xF8474C3BB7CDF024, 0x829F3CF387F8795D,
+		0x66E7A46C27F3AA2C, 0x1C3FD4A417C62355, 0x935745FC4798B8DE, 0xE98F353477AD31A7,
+		0xA6DF411FBFB21CA3, 0xDC0731D78F8795DA, 0x536FA08FDFD90E51, 0x29B7D047EFEC8728,
+	},
+	{64, 0x42F0E1EBA9EA3693, true}: {
+		0x0000000000000000, 0xB32E4CBE03A75F6F, 0xF4843657A840A05B, 0x47AA7AE9ABE7FF34,
+		0x7BD0C384FF8F5E33, 0xC8FE8F3AFC28015C, 0x8F54F5D357CFFE68, 0x3C7AB96D5468A107,
+		0xF7A18709FF1EBC66, 0x448FCBB7FCB9E309, 0x0325B15E575E1C3D, 0xB00BFDE054F94352,
+		0x8C71448D0091E255, 0x3F5F08330336BD3A, 0x78F572DAA8D1420E, 0xCBDB3E64AB761D61,
+		0x7D9BA13851336649, 0xCEB5ED8652943926, 0x891F976FF973C612, 0x3A31DBD1FAD4997D,
+		0x064B62BCAEBC387A, 0xB5652E02AD1B6715, 0xF2CF54EB06FC9821, 0x41E11855055BC74E,
+		0x8A3A2631AE2DDA2F, 0x39146A8FAD8A8540, 0x7EBE1066066D7A74, 0xCD905CD805CA251B,
+		0xF1EAE5B551A2841C, 0x42C4A90B5205DB73, 0x056ED3E2F9E22447, 0xB6409F5CFA457B28,
+		0xFB374270A266CC92, 0x48190ECEA1C193FD, 0x0FB374270A266CC9, 0xBC9D3899098133A6,
+		0x80E781F45DE992A1, 0x33C9CD4A5E4ECDCE, 0x7463B7A3F5A932FA, 0xC74DFB1DF60E6D95,
+		0x0C96C5795D7870F4, 0xBFB889C75EDF2F9B, 0xF812F32EF538D0AF, 0x4B3CBF90F69F8FC0,
+		0x774606FDA2F72EC7, 0xC4684A43A15071A8, 0x83C230AA0AB78E9C, 0x30EC7C140910D1F3,
+		0x86ACE348F355AADB, 0x3582AFF6F0F2F5B4, 0x7228D51F5B150A80, 0xC10699A158B255EF,
+		0xFD7C20CC0CDAF4E8, 0x4E526C720F7DAB87, 0x09F8169BA49A54B3, 0xBAD65A25A73D0BDC,
+		0x710D64410C4B16BD, 0xC22328FF0FEC49D2, 0x85895216A40BB6E6, 0x36A71EA8A7ACE989,
+		0x0ADDA7C5F3C4488E, 0xB9F3EB7BF06317E1, 0xFE5991925B84E8D5, 0x4D77DD2C5823B7BA,
+		0x64B62BCAEBC387A1, 0xD7986774E864D8CE, 0x90321D9D438327FA, 0x231C512340247895,
+		0x1F66E84E144CD992, 0xAC48A4F017EB86FD, 0xEBE2DE19BC0C79C9, 0x58CC92A7BFAB26A6,
+		0x9317ACC314DD3BC7, 0x2039E07D177A64A8, 0x67939A94BC9D9B9C, 0xD4BDD62ABF3AC4F3,
+		0xE8C76F47EB5265F4, 0x5BE923F9E8F53A9B, 0x1C4359104312C5AF, 0xAF6D15AE40B59AC0,
+		0x192D8AF2BAF0E1E8, 0xAA03C64CB957BE87, 0xEDA9BCA512B041B3, 0x5E87F01B11171EDC,
+		0x62FD4976457FBFDB, 0xD1D305C846D8E0B4, 0x96797F21ED3F1F80, 0x2557339FEE9840EF,
+		0xEE8C0DFB45EE5D8E, 0x5DA24145464902E1, 0x1A083BACEDAEFDD5, 0xA9267712EE09A2BA,
+		0x955CCE7FBA6103BD, 0x267282C1B9C65CD2, 0x61D8F8281221A3E6, 0xD2F6B4961186FC89,
+		0x9F8169BA49A54B33, 0x2CAF25044A02145C, 0x6B055FEDE1E5EB68, 0xD82B1353E242B407,
+		0xE451AA3EB62A1500, 0x577FE680B58D4A6F, 0x10D59C691E6AB55B, 0xA3FBD0D71DCDEA34,
+		0x6820EEB3B6BBF755, 0xDB0EA20DB51CA83A, 0x9CA4D8E41EFB570E, 0x2F8A945A1D5C0861,
+		0x13F02D374934A966, 0xA0DE61894A93F609, 0xE7741B60E174093D, 0x545A57DEE2D35652,
+		0xE21AC88218962D7A, 0x5134843C1B317215, 0x169EFED5B0D68D21, 0xA5B0B26BB371D24E,
+		0x99CA0B06E7197349, 0x2AE447B8E4BE2C26, 0x6D4E3D514F59D312, 0xDE6071EF4CFE8C7D,
+		0x15BB4F8BE788911C, 0xA6950335E42FCE73, 0xE13F79DC4FC83147, 0x521135624C6F6E28,
+		0x6E6B8C0F1807CF2F, 0xDD45C0B11BA09040, 0x9AEFBA58B0476F74, 0x29C1F6E6B3E0301B,
+		0xC96C5795D7870F42, 0x7A421B2BD420502D, 0x3DE861C27FC7AF19, 0x8EC62D7C7C60F076,
+		0xB2BC941128085171, 0x0192D8AF2BAF0E1E, 0x4638A2468048F12A, 0xF516EEF883EFAE45,
+		0x3ECDD09C2899B324, 0x8DE39C222B3EEC4B, 0xCA49E6CB80D9137F, 0x7967AA75837E4C10,
+		0x451D1318D716ED17, 0xF6335FA6D4B1B278, 0xB199254F7F564D4C, 0x02B769F17CF11223,
+		0xB4F7F6AD86B4690B, 0x07D9BA1385133664, 0x4073C0FA2EF4C950, 0xF35D8C442D53963F,
+		0xCF273529793B3738, 0x7C0979977A9C6857, 0x3BA3037ED17B9763, 0x888D4FC0D2DCC80C,
+		0x435671A479AAD56D, 0xF0783D1A7A0D8A02, 0xB7D247F3D1EA7536, 0x04FC0B4DD24D2A59,
+		0x3886B22086258B5E, 0x8BA8FE9E8582D431, 0xCC0284772E652B05, 0x7F2CC8C92DC2746A,
+		0x325B15E575E1C3D0, 0x8175595B76469CBF, 0xC6DF23B2DDA1638B, 0x75F16F0CDE063CE4,
+		0x498BD6618A6E9DE3, 0xFAA59ADF89C9C28C, 0xBD0FE036222E3DB8, 0x0E21AC88218962D7,
+		0xC5FA92EC8AFF7FB6, 0x76D4DE52895820D9, 0x317EA4BB22BFDFED, 0x8250E80521188082,
+		0xBE2A516875702185, 0x0D041DD676D77EEA, 0x4AAE673FDD3081DE, 0xF9802B81DE97DEB1,
+		0x4FC0B4DD24D2A599, 0xFCEEF8632775FAF6, 0xBB44828A8C9205C2, 0x086ACE348F355AAD,
+		0x34107759DB5DFBAA, 0x873E3BE7D8FAA4C5, 0xC094410E731D5BF1, 0x73BA0DB070BA049E,
+		0xB86133D4DBCC19FF, 0x0B4F7F6AD86B4690, 0x4CE50583738CB9A4, 0xFFCB493D702BE6CB,
+		0xC3B1F050244347CC, 0x709FBCEE27E418A3, 0x3735C6078C03E797, 0x841B8AB98FA4B8F8,
+		0xADDA7C5F3C4488E3, 0x1EF430E13FE3D78C, 0x595E4A08940428B8, 0xEA7006B697A377D7,
+		0xD60ABFDBC3CBD6D0, 0x6524F365C06C89BF, 0x228E898C6B8B768B, 0x91A0C532682C29E4,
+		0x5A7BFB56C35A3485, 0xE955B7E8C0FD6BEA, 0xAEFFCD016B1A94DE, 0x1DD181BF68BDCBB1,
+		0x21AB38D23CD56AB6, 0x9285746C3F7235D9, 0xD52F0E859495CAED, 0x6601423B97329582,
+		0xD041DD676D77EEAA, 0x636F91D96ED0B1C5, 0x24C5EB30C5374EF1, 0x97EBA78EC690119E,
+		0xAB911EE392F8B099, 0x18BF525D915FEFF6, 0x5F1528B43AB810C2, 0xEC3B640A391F4FAD,
+		0x27E05A6E926952CC, 0x94CE16D091CE0DA3, 0xD3646C393A29F297, 0x604A2087398EADF8,
+		0x5C3099EA6DE60CFF, 0xEF1ED5546E415390, 0xA8B4AFBDC5A6ACA4, 0x1B9AE303C601F3CB,
+		0x56ED3E2F9E224471, 0xE5C372919D851B1E, 0xA26908783662E42A, 0x114744C635C5BB45,
+		0x2D3DFDAB61AD1A42, 0x9E13B115620A452D, 0xD9B9CBFCC9EDBA19, 0x6A978742CA4AE576,
+		0xA14CB926613CF817, 0x1262F598629BA778, 0x55C88F71C97C584C, 0xE6E6C3CFCADB0723,
+		0xDA9C7AA29EB3A624, 0x69B2361C9D14F94B, 0x2E184CF536F3067F, 0x9D36004B35545910,
+		0x2B769F17CF112238, 0x9858D3A9CCB67D57, 0xDFF2A94067518263, 0x6CDCE5FE64F6DD0C,
+		0x50A65C93309E7C0B, 0xE388102D33392364, 0xA4226AC498DEDC50, 0x170C267A9B79833F,
+		0xDCD7181E300F9E5E, 0x6FF954A033A8C131, 0x28532E49984F3E05, 0x9B7D62F79BE8616A,
+		0xA707DB9ACF80C06D, 0x14299724CC279F02, 0x5383EDCD67C06036, 0xE0ADA17364673F59,
+	},
+}
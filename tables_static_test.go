@@ -0,0 +1,84 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "regenerate tables_static.go")
+
+// TestStaticTables checks that tables_static.go holds tables for all built-in parameter sets,
+// and regenerates it if -update flag is given.
+func TestStaticTables(t *testing.T) {
+	source := generateStaticTables()
+	if *update {
+		if err := os.WriteFile("tables_static.go", source, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	existing, err := os.ReadFile("tables_static.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(existing, source) {
+		t.Errorf("tables_static.go is out of date, run go test -run TestStaticTables -update")
+	}
+}
+
+func generateStaticTables() []byte {
+	var b bytes.Buffer
+	b.WriteString(`// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by go test -run TestStaticTables -update. DO NOT EDIT.
+
+package crc
+
+// staticTables holds precomputed tables for all built-in parameter sets, so that NewTable
+// does not need to calculate them.
+var staticTables = map[tableKey]*[256]uint64{
+`)
+	builtin := []*Parameters{XMODEM2, Koopman} // not in the catalogue
+	for _, entry := range catalogue {
+		builtin = append(builtin, entry.params)
+	}
+	seen := make(map[tableKey]bool)
+	for _, params := range builtin {
+		key := tableKey{params.Width, params.Polynomial, params.ReflectIn}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		fmt.Fprintf(&b, "{%d, 0x%X, %v}: {", key.width, key.poly, key.reflectIn)
+		digits := int(params.Width+3) / 4
+		perLine := 8
+		if digits > 8 {
+			perLine = 4
+		}
+		for i, value := range computeTable(params) {
+			if i%perLine == 0 {
+				b.WriteString("\n")
+			}
+			fmt.Fprintf(&b, "0x%0*X, ", digits, value)
+		}
+		b.WriteString("\n},\n")
+	}
+	b.WriteString("}\n")
+
+	source, err := format.Source(b.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	return source
+}
@@ -0,0 +1,155 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import "math/bits"
+
+// Unsigned lists types TypedTable and TypedHash can calculate CRC in.
+type Unsigned interface {
+	uint8 | uint16 | uint32 | uint64
+}
+
+// TypedTable is same as Table, except it calculates CRC in type T rather than uint64, keeping the register
+// in T as well. So it takes less memory (256 bytes for CRC-8) and spares call sites type conversions.
+// It is immutable once initialized and thread safe as a result.
+//
+// Values passed between InitCrc, UpdateCrc and CRC are internal register values, which are not the same
+// as those of Table: if ReflectIn is not set, the register is kept aligned to the most significant bit of T,
+// so that any width fitting into T works without extra shifts.
+type TypedTable[T Unsigned] struct {
+	crcParams Parameters
+	table     [256]T
+	initValue T
+	mask      uint64
+	shift     uint // number of unused bits in T
+	top       uint // shift bringing the most significant byte of the register down
+}
+
+// NewTypedTable creates and initializes a new TypedTable for the CRC algorithm specified by the crcParams.
+// It validates crcParams first (see Validate) and also makes sure CRC fits into T.
+func NewTypedTable[T Unsigned](crcParams *Parameters) (*TypedTable[T], error) {
+	if err := crcParams.Validate(); err != nil {
+		return nil, err
+	}
+	size := uint(bits.Len64(uint64(^T(0))))
+	if crcParams.Width > size {
+		return nil, &ParametersError{"Width", uint64(crcParams.Width), ErrWidthOutOfRange}
+	}
+
+	t := &TypedTable[T]{crcParams: *crcParams, mask: widthMask(crcParams.Width), top: size - 8}
+	for i, entry := range buildTable(crcParams) {
+		if !crcParams.ReflectIn {
+			entry = (entry & t.mask) << (size - crcParams.Width)
+		}
+		t.table[i] = T(entry)
+	}
+	if crcParams.ReflectIn {
+		t.initValue = T(reflect(crcParams.Init, crcParams.Width))
+	} else {
+		t.shift = size - crcParams.Width
+		t.initValue = T(crcParams.Init << t.shift)
+	}
+	return t, nil
+}
+
+// InitCrc returns a stating value for a new CRC calculation
+func (t *TypedTable[T]) InitCrc() T {
+	return t.initValue
+}
+
+// UpdateCrc process supplied bytes and updates current (partial) CRC accordingly.
+// It can be called repetitively to process larger data in chunks.
+func (t *TypedTable[T]) UpdateCrc(curValue T, p []byte) T {
+	// shifts go through uint64, as T may be too narrow to shift by 8 bits
+	if t.crcParams.ReflectIn {
+		for _, v := range p {
+			curValue = t.table[byte(curValue)^v] ^ T(uint64(curValue)>>8)
+		}
+		return curValue
+	}
+	for _, v := range p {
+		curValue = t.table[byte(curValue>>t.top)^v] ^ T(uint64(curValue)<<8)
+	}
+	return curValue
+}
+
+// CRC returns CRC value for the data processed so far.
+func (t *TypedTable[T]) CRC(curValue T) T {
+	ret := uint64(curValue >> t.shift)
+	if t.crcParams.ReflectOut != t.crcParams.ReflectIn {
+		ret = reflect(ret, t.crcParams.Width)
+	}
+	return T((ret ^ t.crcParams.FinalXor) & t.mask)
+}
+
+// CalculateCRC is a convenience function allowing to calculate CRC in one call.
+func (t *TypedTable[T]) CalculateCRC(data []byte) T {
+	return t.CRC(t.UpdateCrc(t.InitCrc(), data))
+}
+
+// TypedHash is same as Hash, except it calculates CRC in type T using TypedTable. It implements hash.Hash
+// interface, and Sum appends CRC in BigEndian byte order.
+type TypedHash[T Unsigned] struct {
+	table    *TypedTable[T]
+	curValue T
+}
+
+// NewTypedHash creates a new TypedHash instance calculating CRC according to parameters specified,
+// which are validated the same way NewTypedTable does.
+func NewTypedHash[T Unsigned](crcParams *Parameters) (*TypedHash[T], error) {
+	table, err := NewTypedTable[T](crcParams)
+	if err != nil {
+		return nil, err
+	}
+	return NewTypedHashWithTable(table), nil
+}
+
+// NewTypedHashWithTable creates a new TypedHash instance using a TypedTable instance created elsewhere.
+func NewTypedHashWithTable[T Unsigned](table *TypedTable[T]) *TypedHash[T] {
+	return &TypedHash[T]{table: table, curValue: table.InitCrc()}
+}
+
+// Size returns the number of bytes Sum will return.
+func (h *TypedHash[T]) Size() int { return int(h.table.crcParams.Width+7) / 8 }
+
+// BlockSize returns the hash's underlying block size.
+func (h *TypedHash[T]) BlockSize() int { return 1 }
+
+// Reset resets the Hash to its initial state.
+func (h *TypedHash[T]) Reset() {
+	h.curValue = h.table.InitCrc()
+}
+
+// Sum appends the current hash to in and returns the resulting slice.
+// It does not change the underlying hash state.
+func (h *TypedHash[T]) Sum(in []byte) []byte {
+	return BigEndian.Append(in, uint64(h.CRC()), h.table.crcParams.Width)
+}
+
+// Write implements io.Writer interface which is part of hash.Hash interface.
+func (h *TypedHash[T]) Write(p []byte) (n int, err error) {
+	h.Update(p)
+	return len(p), nil
+}
+
+// Update updates process supplied bytes and updates current (partial) CRC accordingly.
+func (h *TypedHash[T]) Update(p []byte) {
+	h.curValue = h.table.UpdateCrc(h.curValue, p)
+}
+
+// CRC returns current CRC value for the data processed so far.
+func (h *TypedHash[T]) CRC() T {
+	return h.table.CRC(h.curValue)
+}
+
+// CalculateCRC is a convenience function allowing to calculate CRC in one call.
+func (h *TypedHash[T]) CalculateCRC(data []byte) T {
+	return h.table.CalculateCRC(data)
+}
+
+// Table used by this TypedHash under the hood
+func (h *TypedHash[T]) Table() *TypedTable[T] {
+	return h.table
+}
@@ -0,0 +1,66 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"bytes"
+	"errors"
+	"hash"
+	"testing"
+)
+
+func testTypedTable[T Unsigned](t *testing.T, params *Parameters, data []byte) {
+	table, err := NewTypedTable[T](params)
+	if err != nil {
+		t.Fatalf("Unexpected error creating TypedTable for %s: %v", params.Name, err)
+	}
+	if crc := table.CalculateCRC(checkInput); uint64(crc) != params.Check {
+		t.Errorf("Incorrect %s check value 0x%X calculated by TypedTable[%T] (should be 0x%X)", params.Name, crc, crc, params.Check)
+	}
+
+	h := NewTypedHashWithTable(table)
+	h.Update(data[:7])
+	h.Update(data[7:])
+	expected := NewHash(params)
+	expected.Update(data)
+	if crc := h.CRC(); uint64(crc) != expected.CRC() {
+		t.Errorf("Incorrect %s CRC 0x%X calculated by TypedHash[%T] in chunks (should be 0x%X)", params.Name, crc, crc, expected.CRC())
+	}
+	if sum := h.Sum(nil); !bytes.Equal(sum, expected.Sum(nil)) || h.Size() != len(sum) {
+		t.Errorf("Incorrect %s sum % X calculated by TypedHash[%T] (should be % X)", params.Name, sum, h.CRC(), expected.Sum(nil))
+	}
+}
+
+func TestTypedTable(t *testing.T) {
+	data := []byte("typed tables keep the register in T")
+	for _, entry := range catalogue {
+		params := entry.params
+		if params.Width <= 8 {
+			testTypedTable[uint8](t, params, data)
+		}
+		if params.Width <= 16 {
+			testTypedTable[uint16](t, params, data)
+		}
+		if params.Width <= 32 {
+			testTypedTable[uint32](t, params, data)
+		}
+		testTypedTable[uint64](t, params, data)
+	}
+
+	if _, err := NewTypedTable[uint16](CRC32); !errors.Is(err, ErrWidthOutOfRange) {
+		t.Errorf("Unexpected error %v creating TypedTable[uint16] for CRC-32 (should be ErrWidthOutOfRange)", err)
+	}
+	if _, err := NewTypedHash[uint32](&Parameters{Width: 16, Polynomial: 0x1020}); !errors.Is(err, ErrEvenPolynomial) {
+		t.Errorf("Unexpected error %v creating TypedHash for even polynomial (should be ErrEvenPolynomial)", err)
+	}
+
+	var _ hash.Hash = &TypedHash[uint32]{}
+
+	h := NewHash(CRC64XZ)
+	h.Update(checkInput)
+	if h.CRC64() != CRC64XZ.Check || NewTable(CRC64XZ).CRC64(h.curValue) != CRC64XZ.Check {
+		t.Errorf("Incorrect CRC 0x%X returned by CRC64 (should be 0x%X)", h.CRC64(), CRC64XZ.Check)
+	}
+}
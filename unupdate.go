@@ -0,0 +1,87 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+// Unupdate undoes what UpdateCrc does, i.e. returns the register value which turns into state
+// after suffix is processed, so that t.Unupdate(t.UpdateCrc(s, p), p) == s. It helps to answer
+// questions like "what was the CRC before this trailer was appended", or to find Init producing
+// a given CRC. To start from a final CRC value, convert it to a register value with StateFromCRC first:
+//
+//	state := t.Unupdate(t.StateFromCRC(crc), trailer)
+//	crcBeforeTrailer := t.CRC(state)
+//
+// Processing a byte can be undone since its effect on the register is determined by one byte of table entry
+// (the one not affected by the register shift), which is unique for each entry. So Unupdate uses a reverse table
+// mapping that byte back to table index, built on first use. CRCs narrower than 8 bits are undone bit by bit.
+func (t *Table) Unupdate(state uint64, suffix []byte) uint64 {
+	width := t.crcParams.Width
+	state &= t.mask
+	if width < 8 {
+		for i := len(suffix) - 1; i >= 0; i-- {
+			state = t.unupdateBitwise(state, suffix[i])
+		}
+		return state
+	}
+
+	t.reverseOnce.Do(t.initReverse)
+	if t.crcParams.ReflectIn {
+		for i := len(suffix) - 1; i >= 0; i-- {
+			idx := t.reverse[byte(state>>(width-8))]
+			state = ((state^t.crctable[idx])<<8 | uint64(idx^suffix[i])) & t.mask
+		}
+	} else {
+		for i := len(suffix) - 1; i >= 0; i-- {
+			idx := t.reverse[byte(state)]
+			state = (state^t.crctable[idx])&t.mask>>8 | uint64(idx^suffix[i])<<(width-8)
+		}
+	}
+	return state
+}
+
+// initReverse builds reverse table, which maps the most significant byte of table entries (for reflected
+// algorithms) or the least significant one (for non reflected algorithms) to table index.
+func (t *Table) initReverse() {
+	reverse := new([256]byte)
+	for i, entry := range t.crctable {
+		if t.crcParams.ReflectIn {
+			reverse[byte(entry>>(t.crcParams.Width-8))] = byte(i)
+		} else {
+			reverse[byte(entry)] = byte(i)
+		}
+	}
+	t.reverse = reverse
+}
+
+// unupdateBitwise undoes processing of a single byte b bit by bit. Since polynomial is odd, the bit shifted
+// out of the register is known from the bit of the polynomial not affected by the shift.
+func (t *Table) unupdateBitwise(state uint64, b byte) uint64 {
+	width, poly := t.crcParams.Width, t.crcParams.Polynomial
+	topBit := uint64(1) << (width - 1)
+	if t.crcParams.ReflectIn {
+		poly = reflect(poly, width)
+		for bit := 7; bit >= 0; bit-- {
+			out := state & topBit
+			if out != 0 {
+				state ^= poly
+			}
+			state <<= 1
+			if (out != 0) != (b&(1<<uint(bit)) != 0) {
+				state |= 1
+			}
+		}
+		return state & t.mask
+	}
+	for bit := 0; bit < 8; bit++ {
+		out := state & 1
+		if out != 0 {
+			state ^= poly
+		}
+		state >>= 1
+		if (out != 0) != (b&(1<<uint(bit)) != 0) {
+			state |= topBit
+		}
+	}
+	return state
+}
@@ -0,0 +1,28 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import "testing"
+
+func TestUnupdate(t *testing.T) {
+	data := []byte("captured frame payload with a trailer")
+	for _, entry := range catalogue {
+		params := entry.params
+		table := NewTable(params)
+
+		// undoing the whole message has to lead back to Init
+		state := table.Unupdate(table.StateFromCRC(table.CalculateCRC(data)), data)
+		if state != table.InitCrc()&table.mask {
+			t.Errorf("Incorrect %s register 0x%X after undoing whole message (should be 0x%X)", params.Name, state, table.InitCrc())
+		}
+
+		// CRC before the trailer was appended
+		prefix, trailer := data[:20], data[20:]
+		crc := table.CRC(table.Unupdate(table.StateFromCRC(table.CalculateCRC(data)), trailer))
+		if expected := table.CalculateCRC(prefix); crc != expected {
+			t.Errorf("Incorrect %s CRC 0x%X of prefix recovered from whole message (should be 0x%X)", params.Name, crc, expected)
+		}
+	}
+}
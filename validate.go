@@ -0,0 +1,78 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors reported by Validate. They are wrapped into ParametersError, so use errors.Is to check for them.
+var (
+	// ErrNilParameters means no Parameters were supplied at all
+	ErrNilParameters = errors.New("crc: parameters are nil")
+	// ErrWidthOutOfRange means Width is not between 1 and 64 bits
+	ErrWidthOutOfRange = errors.New("crc: width out of range")
+	// ErrPolynomialTooWide means Polynomial has bits set beyond Width
+	ErrPolynomialTooWide = errors.New("crc: polynomial has bits beyond width")
+	// ErrEvenPolynomial means Polynomial does not have its lowest bit (x^0 term) set
+	ErrEvenPolynomial = errors.New("crc: polynomial is even")
+	// ErrValueTooWide means Init, FinalXor, Check or Residue has bits set beyond Width
+	ErrValueTooWide = errors.New("crc: value has bits beyond width")
+)
+
+// ParametersError describes a problem found in Parameters by Validate.
+type ParametersError struct {
+	Field string // Field is the name of the offending Parameters field
+	Value uint64 // Value is the value of the offending field
+	Err   error  // Err is one of the errors listed above describing the problem
+}
+
+func (e *ParametersError) Error() string {
+	if e.Field == "Width" {
+		return fmt.Sprintf("%v (Width is %d)", e.Err, e.Value)
+	}
+	return fmt.Sprintf("%v (%s is 0x%X)", e.Err, e.Field, e.Value)
+}
+
+// Unwrap returns underlying error, so errors.Is(err, ErrEvenPolynomial) works as expected.
+func (e *ParametersError) Unwrap() error { return e.Err }
+
+// Validate checks that these Parameters describe a CRC algorithm this package can calculate correctly.
+// It returns nil if they do, or a *ParametersError describing the first problem found otherwise.
+func (crcParams *Parameters) Validate() error {
+	if crcParams == nil {
+		return ErrNilParameters
+	}
+	if crcParams.Width < 1 || crcParams.Width > 64 {
+		return &ParametersError{"Width", uint64(crcParams.Width), ErrWidthOutOfRange}
+	}
+	mask := widthMask(crcParams.Width)
+	if crcParams.Polynomial&^mask != 0 {
+		return &ParametersError{"Polynomial", crcParams.Polynomial, ErrPolynomialTooWide}
+	}
+	if crcParams.Polynomial&1 == 0 {
+		return &ParametersError{"Polynomial", crcParams.Polynomial, ErrEvenPolynomial}
+	}
+	for _, field := range []struct {
+		name  string
+		value uint64
+	}{
+		{"Init", crcParams.Init},
+		{"FinalXor", crcParams.FinalXor},
+		{"Check", crcParams.Check},
+		{"Residue", crcParams.Residue},
+	} {
+		if field.value&^mask != 0 {
+			return &ParametersError{field.name, field.value, ErrValueTooWide}
+		}
+	}
+	return nil
+}
+
+// widthMask returns a mask with lowest width bits set. Unlike (1 << width) - 1 it also works for width of 64.
+func widthMask(width uint) uint64 {
+	return ^uint64(0) >> (64 - width)
+}
@@ -0,0 +1,70 @@
+package crc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	doTest := func(params *Parameters, field string, expected error) {
+		err := params.Validate()
+		if expected == nil {
+			if err != nil {
+				t.Errorf("Unexpected error for %+v: %v", params, err)
+			}
+			return
+		}
+		if !errors.Is(err, expected) {
+			t.Errorf("Validate returned %v for %+v when should return %v", err, params, expected)
+			return
+		}
+		var paramsErr *ParametersError
+		if field != "" && (!errors.As(err, &paramsErr) || paramsErr.Field != field) {
+			t.Errorf("Validate returned %v for %+v when should complain about %s", err, params, field)
+		}
+	}
+
+	for _, entry := range catalogue {
+		doTest(entry.params, "", nil)
+	}
+	doTest(&Parameters{Width: 1, Polynomial: 0x1}, "", nil)
+	doTest(&Parameters{Width: 64, Polynomial: 0x1B, Init: 0xFFFFFFFFFFFFFFFF}, "", nil)
+
+	doTest(nil, "", ErrNilParameters)
+	doTest(&Parameters{Width: 0, Polynomial: 0x1}, "Width", ErrWidthOutOfRange)
+	doTest(&Parameters{Width: 65, Polynomial: 0x1}, "Width", ErrWidthOutOfRange)
+	doTest(&Parameters{Width: 16, Polynomial: 0x11021}, "Polynomial", ErrPolynomialTooWide)
+	doTest(&Parameters{Width: 16, Polynomial: 0x8408}, "Polynomial", ErrEvenPolynomial)
+	doTest(&Parameters{Width: 16, Polynomial: 0}, "Polynomial", ErrEvenPolynomial)
+	doTest(&Parameters{Width: 5, Polynomial: 0x05, Init: 0xFF}, "Init", ErrValueTooWide)
+	doTest(&Parameters{Width: 5, Polynomial: 0x05, FinalXor: 0x20}, "FinalXor", ErrValueTooWide)
+	doTest(&Parameters{Width: 5, Polynomial: 0x05, Check: 0x20}, "Check", ErrValueTooWide)
+	doTest(&Parameters{Width: 5, Polynomial: 0x05, Residue: 0x40}, "Residue", ErrValueTooWide)
+}
+
+func TestErrorReturningConstructors(t *testing.T) {
+	if table, err := NewTableE(&Parameters{Width: 0, Polynomial: 0x1}); table != nil || err == nil {
+		t.Errorf("NewTableE accepted invalid parameters")
+	}
+	if h, err := NewHashE(&Parameters{Width: 70, Polynomial: 0x1}); h != nil || err == nil {
+		t.Errorf("NewHashE accepted invalid parameters")
+	}
+	if h, err := NewHashE(nil); h != nil || !errors.Is(err, ErrNilParameters) {
+		t.Errorf("NewHashE accepted nil parameters")
+	}
+
+	table, err := NewTableE(CRC16MODBUS)
+	if err != nil {
+		t.Fatalf("NewTableE failed for valid parameters: %v", err)
+	}
+	if crc := table.CalculateCRC(checkInput); crc != CRC16MODBUS.Check {
+		t.Errorf("Incorrect CRC 0x%04x calculated (should be 0x%04x)", crc, CRC16MODBUS.Check)
+	}
+	h, err := NewHashE(CRC16MODBUS)
+	if err != nil {
+		t.Fatalf("NewHashE failed for valid parameters: %v", err)
+	}
+	if crc := h.CalculateCRC(checkInput); crc != CRC16MODBUS.Check {
+		t.Errorf("Incorrect CRC 0x%04x calculated (should be 0x%04x)", crc, CRC16MODBUS.Check)
+	}
+}
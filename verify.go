@@ -0,0 +1,41 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+// Verify checks whether frame consisting of a message followed by its CRC stored in a given byte order
+// (as produced by order.Append) is intact. It works for any width, ignoring unused bits of the stored CRC if any.
+//
+// If CRC bits are stored in the order they enter the register (e.g. LittleEndian for reflected algorithms
+// and BigEndian for non reflected ones with width multiple of 8), whole frame is fed through UpdateCrc
+// and the register is compared against the residue. Otherwise, CRC is stripped and compared with the CRC
+// calculated over the message.
+func (t *Table) Verify(frame []byte, order ByteOrder) bool {
+	width := t.crcParams.Width
+	size := int(width+7) / 8
+	if len(frame) < size {
+		return false
+	}
+	if width%8 == 0 && order == t.registerOrder() {
+		_, residueInput := residueRun(&t.crcParams)
+		residue := t.updateBytewise(0, residueInput) & t.mask
+		return t.UpdateCrc(t.InitCrc(), frame)&t.mask == residue
+	}
+	message, stored := frame[:len(frame)-size], frame[len(frame)-size:]
+	return order.Decode(stored, width) == t.CalculateCRC(message)
+}
+
+// registerOrder returns byte order which stores CRC bits in the order they enter the register when fed
+// to UpdateCrc, or -1 if there is no such order.
+func (t *Table) registerOrder() ByteOrder {
+	switch {
+	case t.crcParams.ReflectIn && t.crcParams.ReflectOut:
+		return LittleEndian
+	case !t.crcParams.ReflectIn && !t.crcParams.ReflectOut:
+		return BigEndian
+	case !t.crcParams.ReflectIn && t.crcParams.ReflectOut:
+		return BitReversed
+	}
+	return -1
+}
@@ -0,0 +1,38 @@
+package crc
+
+import "testing"
+
+func TestVerify(t *testing.T) {
+	message := []byte("Whenever digital data is stored or interfaced, data corruption might occur.")
+
+	doTest := func(params *Parameters) {
+		table := NewTable(params)
+		crc := table.CalculateCRC(message)
+		for _, order := range []ByteOrder{BigEndian, LittleEndian, BitReversed} {
+			frame := order.Append(append([]byte(nil), message...), crc, params.Width)
+			if !table.Verify(frame, order) {
+				t.Errorf("Valid %v frame rejected for %s", order, params.Name)
+			}
+			used := append(make([]byte, len(message)), order.Append(nil, widthMask(params.Width), params.Width)...)
+			for bit := 0; bit < 8*len(frame); bit += 3 {
+				if used[bit/8]&(0x80>>uint(bit%8)) == 0 && bit >= 8*len(message) {
+					continue // unused bit of stored CRC
+				}
+				frame[bit/8] ^= 0x80 >> uint(bit%8)
+				if table.Verify(frame, order) {
+					t.Errorf("Corrupted %v frame (bit %d) accepted for %s", order, bit, params.Name)
+				}
+				frame[bit/8] ^= 0x80 >> uint(bit%8)
+			}
+		}
+		if table.Verify(message[:(params.Width+7)/8-1], BigEndian) {
+			t.Errorf("Too short frame accepted for %s", params.Name)
+		}
+	}
+
+	for _, entry := range catalogue {
+		doTest(entry.params)
+	}
+	doTest(&Parameters{Width: 16, Polynomial: 0x1021, Init: 0x1D0F, ReflectIn: true, ReflectOut: false, FinalXor: 0x5555})
+	doTest(&Parameters{Width: 32, Polynomial: 0x04C11DB7, Init: 0xFFFFFFFF, ReflectIn: false, ReflectOut: true, FinalXor: 0xFFFFFFFF})
+}
@@ -0,0 +1,333 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Uint128 is an unsigned 128-bit integer holding wide CRC values and polynomials.
+type Uint128 struct {
+	Hi uint64 // Hi holds the most significant 64 bits
+	Lo uint64 // Lo holds the least significant 64 bits
+}
+
+// String returns the value in hexadecimal
+func (u Uint128) String() string {
+	if u.Hi == 0 {
+		return fmt.Sprintf("0x%X", u.Lo)
+	}
+	return fmt.Sprintf("0x%X%016X", u.Hi, u.Lo)
+}
+
+func (u Uint128) xor(v Uint128) Uint128 {
+	return Uint128{u.Hi ^ v.Hi, u.Lo ^ v.Lo}
+}
+
+func (u Uint128) and(v Uint128) Uint128 {
+	return Uint128{u.Hi & v.Hi, u.Lo & v.Lo}
+}
+
+func (u Uint128) lsh(n uint) Uint128 {
+	if n >= 64 {
+		return Uint128{u.Lo << (n - 64), 0}
+	}
+	return Uint128{u.Hi<<n | u.Lo>>(64-n), u.Lo << n}
+}
+
+func (u Uint128) rsh(n uint) Uint128 {
+	if n >= 64 {
+		return Uint128{0, u.Hi >> (n - 64)}
+	}
+	return Uint128{u.Hi >> n, u.Lo>>n | u.Hi<<(64-n)}
+}
+
+// bit returns n-th bit of u
+func (u Uint128) bit(n uint) bool {
+	return u.rsh(n).Lo&1 != 0
+}
+
+// wideMask returns a value with width least significant bits set
+func wideMask(width uint) Uint128 {
+	return Uint128{^uint64(0), ^uint64(0)}.rsh(128 - width)
+}
+
+// reflectWide reverses order of last count bits
+func reflectWide(in Uint128, count uint) Uint128 {
+	return Uint128{bits.Reverse64(in.Lo), bits.Reverse64(in.Hi)}.rsh(128 - count)
+}
+
+// WideParameters represents set of parameters defining a particular CRC algorithm up to 128 bits wide.
+// Fields have the same meaning as those of Parameters.
+type WideParameters struct {
+	Width      uint // Width of the CRC expressed in bits
+	Polynomial Uint128
+	ReflectIn  bool
+	ReflectOut bool
+	Init       Uint128
+	FinalXor   Uint128
+	Check      Uint128
+	Residue    Uint128
+	Name       string
+}
+
+// CRC82DARC is CRC-82/DARC, the only CRC wider than 64 bits in CRC RevEng catalogue.
+// Lookup only covers CRCs up to 64 bits wide, so use LookupWide to find it by name.
+var CRC82DARC = &WideParameters{
+	Name:       "CRC-82/DARC",
+	Width:      82,
+	Polynomial: Uint128{0x308C, 0x0111011401440411},
+	ReflectIn:  true,
+	ReflectOut: true,
+	Check:      Uint128{0x9EA8, 0x3F625023801FD612},
+}
+
+// wideCatalogue lists every named wide parameter set known to this package
+var wideCatalogue = []*WideParameters{CRC82DARC}
+
+// LookupWide finds a parameter set of a CRC wider than 64 bits by its name as listed in CRC RevEng catalogue.
+// Matching ignores case and punctuation, same as Lookup does. Returned WideParameters are shared, so do not modify them.
+func LookupWide(name string) (*WideParameters, bool) {
+	name = normalizeName(name)
+	for _, params := range wideCatalogue {
+		if normalizeName(params.Name) == name {
+			return params, true
+		}
+	}
+	return nil, false
+}
+
+// Validate checks that these WideParameters describe a CRC algorithm this package can calculate correctly.
+// It returns nil if they do, or a *ParametersError describing the first problem found otherwise.
+// Value of ParametersError holds the least significant 64 bits of the offending field.
+func (crcParams *WideParameters) Validate() error {
+	if crcParams == nil {
+		return ErrNilParameters
+	}
+	if crcParams.Width < 1 || crcParams.Width > 128 {
+		return &ParametersError{"Width", uint64(crcParams.Width), ErrWidthOutOfRange}
+	}
+	mask := wideMask(crcParams.Width)
+	if crcParams.Polynomial.and(mask) != crcParams.Polynomial {
+		return &ParametersError{"Polynomial", crcParams.Polynomial.Lo, ErrPolynomialTooWide}
+	}
+	if crcParams.Polynomial.Lo&1 == 0 {
+		return &ParametersError{"Polynomial", crcParams.Polynomial.Lo, ErrEvenPolynomial}
+	}
+	for _, field := range []struct {
+		name  string
+		value Uint128
+	}{
+		{"Init", crcParams.Init},
+		{"FinalXor", crcParams.FinalXor},
+		{"Check", crcParams.Check},
+		{"Residue", crcParams.Residue},
+	} {
+		if field.value.and(mask) != field.value {
+			return &ParametersError{field.name, field.value.Lo, ErrValueTooWide}
+		}
+	}
+	return nil
+}
+
+// CalculateWideCRC implements simple straight forward bit by bit calculation.
+// It is relatively slow for large amounts of data, but does not require any preparation steps.
+// As a result, it might be faster in some cases then building a table required for faster calculation.
+func CalculateWideCRC(crcParams *WideParameters, data []byte) Uint128 {
+	curValue := crcParams.Init
+	topBit := crcParams.Width - 1
+	mask := wideMask(crcParams.Width)
+
+	for _, b := range data {
+		curByte := b
+		if crcParams.ReflectIn {
+			curByte = bits.Reverse8(curByte)
+		}
+		for j := byte(0x80); j != 0; j >>= 1 {
+			bit := curValue.bit(topBit) != (curByte&j != 0)
+			curValue = curValue.lsh(1).and(mask)
+			if bit {
+				curValue = curValue.xor(crcParams.Polynomial)
+			}
+		}
+	}
+	if crcParams.ReflectOut {
+		curValue = reflectWide(curValue, crcParams.Width)
+	}
+	return curValue.xor(crcParams.FinalXor).and(mask)
+}
+
+// WideTable is same as Table, except it calculates CRCs up to 128 bits wide. If ReflectIn is not set,
+// the register is kept aligned to the most significant bit of Uint128 between calls, so it is not
+// interchangeable with values calculated by CalculateWideCRC until it is passed to CRC.
+// It is essentially immutable once initialized and thread safe as a result.
+type WideTable struct {
+	crcParams WideParameters
+	crctable  [256]Uint128
+	initValue Uint128
+	shift     uint // number of unused bits in Uint128
+}
+
+// NewWideTable creates and initializes a new WideTable for the CRC algorithm specified by the crcParams.
+func NewWideTable(crcParams *WideParameters) *WideTable {
+	t := &WideTable{crcParams: *crcParams}
+	width := crcParams.Width
+	poly := crcParams.Polynomial
+	if crcParams.ReflectIn {
+		poly = reflectWide(poly, width)
+		t.initValue = reflectWide(crcParams.Init, width)
+	} else {
+		t.shift = 128 - width
+		poly = poly.lsh(t.shift)
+		t.initValue = crcParams.Init.lsh(t.shift)
+	}
+
+	for i := range t.crctable {
+		var entry Uint128
+		for j := uint(0); j < 8; j++ {
+			if crcParams.ReflectIn {
+				feedback := (entry.Lo&1 != 0) != (i&(1<<j) != 0)
+				entry = entry.rsh(1)
+				if feedback {
+					entry = entry.xor(poly)
+				}
+			} else {
+				feedback := (entry.Hi>>63 != 0) != (i&(0x80>>j) != 0)
+				entry = entry.lsh(1)
+				if feedback {
+					entry = entry.xor(poly)
+				}
+			}
+		}
+		t.crctable[i] = entry
+	}
+	return t
+}
+
+// NewWideTableE is same as NewWideTable, except it validates crcParams first
+// and returns an error instead of a WideTable calculating wrong CRC values if they are invalid.
+func NewWideTableE(crcParams *WideParameters) (*WideTable, error) {
+	if err := crcParams.Validate(); err != nil {
+		return nil, err
+	}
+	return NewWideTable(crcParams), nil
+}
+
+// InitCrc returns a stating value for a new CRC calculation
+func (t *WideTable) InitCrc() Uint128 {
+	return t.initValue
+}
+
+// UpdateCrc process supplied bytes and updates current (partial) CRC accordingly.
+// It can be called repetitively to process larger data in chunks.
+func (t *WideTable) UpdateCrc(curValue Uint128, p []byte) Uint128 {
+	if t.crcParams.ReflectIn {
+		for _, v := range p {
+			entry := t.crctable[byte(curValue.Lo)^v]
+			curValue = Uint128{entry.Hi ^ curValue.Hi>>8, entry.Lo ^ (curValue.Lo>>8 | curValue.Hi<<56)}
+		}
+		return curValue
+	}
+	for _, v := range p {
+		entry := t.crctable[byte(curValue.Hi>>56)^v]
+		curValue = Uint128{entry.Hi ^ (curValue.Hi<<8 | curValue.Lo>>56), entry.Lo ^ curValue.Lo<<8}
+	}
+	return curValue
+}
+
+// CRC returns CRC value for the data processed so far.
+func (t *WideTable) CRC(curValue Uint128) Uint128 {
+	ret := curValue.rsh(t.shift)
+	if t.crcParams.ReflectOut != t.crcParams.ReflectIn {
+		ret = reflectWide(ret, t.crcParams.Width)
+	}
+	return ret.xor(t.crcParams.FinalXor).and(wideMask(t.crcParams.Width))
+}
+
+// CalculateCRC is a convenience function allowing to calculate CRC in one call.
+func (t *WideTable) CalculateCRC(data []byte) Uint128 {
+	return t.CRC(t.UpdateCrc(t.InitCrc(), data))
+}
+
+// WideHash is same as Hash, except it calculates CRCs up to 128 bits wide using WideTable.
+// It implements hash.Hash interface, and Sum appends CRC in big endian byte order.
+type WideHash struct {
+	table    *WideTable
+	curValue Uint128
+	size     uint
+}
+
+// NewWideHashWithTable creates a new WideHash instance using a WideTable instance created elsewhere.
+func NewWideHashWithTable(table *WideTable) *WideHash {
+	ret := &WideHash{table: table}
+	ret.size = (table.crcParams.Width + 7) / 8 // smalest number of bytes enough to store produced crc
+	ret.Reset()
+	return ret
+}
+
+// NewWideHash creates a new WideHash instance configured for table driven
+// CRC calculation according to parameters specified.
+func NewWideHash(crcParams *WideParameters) *WideHash {
+	return NewWideHashWithTable(NewWideTable(crcParams))
+}
+
+// NewWideHashE is same as NewWideHash, except it validates crcParams first
+// and returns an error instead of a WideHash calculating wrong CRC values if they are invalid.
+func NewWideHashE(crcParams *WideParameters) (*WideHash, error) {
+	table, err := NewWideTableE(crcParams)
+	if err != nil {
+		return nil, err
+	}
+	return NewWideHashWithTable(table), nil
+}
+
+// Size returns the number of bytes Sum will return.
+func (h *WideHash) Size() int { return int(h.size) }
+
+// BlockSize returns the hash's underlying block size.
+func (h *WideHash) BlockSize() int { return 1 }
+
+// Reset resets the Hash to its initial state.
+func (h *WideHash) Reset() {
+	h.curValue = h.table.InitCrc()
+}
+
+// Sum appends the current hash to in and returns the resulting slice.
+// It does not change the underlying hash state.
+func (h *WideHash) Sum(in []byte) []byte {
+	crc := h.CRC()
+	for i := h.size; i > 0; {
+		i--
+		in = append(in, byte(crc.rsh(8*i).Lo))
+	}
+	return in
+}
+
+// Write implements io.Writer interface which is part of hash.Hash interface.
+func (h *WideHash) Write(p []byte) (n int, err error) {
+	h.Update(p)
+	return len(p), nil
+}
+
+// Update updates process supplied bytes and updates current (partial) CRC accordingly.
+func (h *WideHash) Update(p []byte) {
+	h.curValue = h.table.UpdateCrc(h.curValue, p)
+}
+
+// CRC returns current CRC value for the data processed so far.
+func (h *WideHash) CRC() Uint128 {
+	return h.table.CRC(h.curValue)
+}
+
+// CalculateCRC is a convenience function allowing to calculate CRC in one call.
+func (h *WideHash) CalculateCRC(data []byte) Uint128 {
+	return h.table.CalculateCRC(data)
+}
+
+// Table used by this WideHash under the hood
+func (h *WideHash) Table() *WideTable {
+	return h.table
+}
@@ -0,0 +1,92 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"errors"
+	"hash"
+	"testing"
+)
+
+func TestWideCRC(t *testing.T) {
+	data := []byte("wide CRCs are calculated in 128-bit registers")
+
+	if crc := CalculateWideCRC(CRC82DARC, checkInput); crc != CRC82DARC.Check {
+		t.Errorf("Incorrect CRC-82/DARC %v calculated bit by bit (should be %v)", crc, CRC82DARC.Check)
+	}
+	if crc := NewWideTable(CRC82DARC).CalculateCRC(checkInput); crc != CRC82DARC.Check {
+		t.Errorf("Incorrect CRC-82/DARC %v calculated by table (should be %v)", crc, CRC82DARC.Check)
+	}
+	h := NewWideHash(CRC82DARC)
+	h.Update(checkInput[:4])
+	h.Update(checkInput[4:])
+	sum := h.Sum(nil)
+	if len(sum) != 11 || h.Size() != 11 || sum[1] != 0x9E || sum[10] != 0x12 || h.CRC() != CRC82DARC.Check {
+		t.Errorf("Incorrect CRC-82/DARC sum % X calculated by hash", sum)
+	}
+	var _ hash.Hash = h
+
+	// wide implementation has to agree with the usual one for narrower CRCs
+	for _, entry := range catalogue {
+		params := entry.params
+		wide := &WideParameters{
+			Width:      params.Width,
+			Polynomial: Uint128{Lo: params.Polynomial},
+			ReflectIn:  params.ReflectIn,
+			ReflectOut: params.ReflectOut,
+			Init:       Uint128{Lo: params.Init},
+			FinalXor:   Uint128{Lo: params.FinalXor},
+		}
+		expected := Uint128{Lo: CalculateCRC(params, data)}
+		if crc := CalculateWideCRC(wide, data); crc != expected {
+			t.Errorf("Incorrect %s CRC %v calculated bit by bit as wide CRC (should be %v)", params.Name, crc, expected)
+		}
+		if crc := NewWideTable(wide).CalculateCRC(data); crc != expected {
+			t.Errorf("Incorrect %s CRC %v calculated by table as wide CRC (should be %v)", params.Name, crc, expected)
+		}
+	}
+
+	// and table has to agree with bitwise calculation for wider ones
+	for _, wide := range []*WideParameters{
+		{Width: 96, Polynomial: Uint128{0x12345678, 0x9ABCDEF012345679}, Init: Uint128{0xFFFFFFFF, 0xFFFFFFFFFFFFFFFF}},
+		{Width: 96, Polynomial: Uint128{0x12345678, 0x9ABCDEF012345679}, ReflectIn: true, FinalXor: Uint128{0x1, 0x2}},
+		{Width: 128, Polynomial: Uint128{0x8000000000000000, 0x87}, Init: Uint128{0x1, 0x2}, ReflectOut: true},
+		{Width: 128, Polynomial: Uint128{0x8000000000000000, 0x87}, ReflectIn: true, ReflectOut: true},
+		{Width: 65, Polynomial: Uint128{0x1, 0x1B}, ReflectIn: true, FinalXor: Uint128{0x1, 0xFFFFFFFFFFFFFFFF}},
+	} {
+		if err := wide.Validate(); err != nil {
+			t.Fatalf("Unexpected error validating %+v: %v", wide, err)
+		}
+		expected := CalculateWideCRC(wide, data)
+		table := NewWideTable(wide)
+		crc := table.UpdateCrc(table.InitCrc(), data[:10])
+		crc = table.UpdateCrc(crc, data[10:])
+		if table.CRC(crc) != expected {
+			t.Errorf("Incorrect %d-bit CRC %v calculated by table (should be %v)", wide.Width, table.CRC(crc), expected)
+		}
+	}
+
+	if _, err := NewWideTableE(&WideParameters{Width: 129, Polynomial: Uint128{Lo: 1}}); !errors.Is(err, ErrWidthOutOfRange) {
+		t.Errorf("Unexpected error %v creating table for 129-bit CRC (should be ErrWidthOutOfRange)", err)
+	}
+	if err := (&WideParameters{Width: 82, Polynomial: Uint128{0x40000, 1}}).Validate(); !errors.Is(err, ErrPolynomialTooWide) {
+		t.Errorf("Unexpected error %v validating too wide polynomial (should be ErrPolynomialTooWide)", err)
+	}
+	if err := CRC82DARC.Validate(); err != nil {
+		t.Errorf("Unexpected error validating CRC-82/DARC: %v", err)
+	}
+	if params, ok := LookupWide("crc82darc"); !ok || params != CRC82DARC {
+		t.Errorf("CRC-82/DARC not found by LookupWide")
+	}
+	if _, ok := LookupWide("CRC-32"); ok {
+		t.Errorf("LookupWide found CRC-32")
+	}
+	if _, err := NewWideHashE(&WideParameters{Width: 82, Polynomial: Uint128{0x308C, 0x0111011401440410}}); !errors.Is(err, ErrEvenPolynomial) {
+		t.Errorf("Unexpected error %v creating hash for even polynomial (should be ErrEvenPolynomial)", err)
+	}
+	if h, err := NewWideHashE(CRC82DARC); err != nil || h.CalculateCRC(checkInput) != CRC82DARC.Check {
+		t.Errorf("Incorrect hash created for CRC-82/DARC, error %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// WordMode describes how a hardware CRC unit, like those found in STM32, NXP or TI microcontrollers,
+// takes its input. Such units are fed whole 16 or 32-bit words rather than bytes, and reverse
+// input bits in bytes, half-words or whole words, as configured.
+type WordMode struct {
+	Size         uint // Size of input words in bits: 8, 16 or 32
+	LittleEndian bool // LittleEndian is set if words are stored in data least significant byte first, as on most MCUs
+	Reflect      uint // Reflect is the size of units, in bits, bit order of input words is reversed in: 0 (none), 8, 16 or 32
+}
+
+// WordParameters combine CRC algorithm with the way its input is fed in words.
+type WordParameters struct {
+	Parameters *Parameters
+	Mode       WordMode
+}
+
+// ErrInvalidWordMode means WordMode has Size or Reflect not supported
+var ErrInvalidWordMode = errors.New("crc: invalid word mode")
+
+// Presets emulating common configurations of CRC peripherals, with words written from a little endian CPU.
+// Note that any final XOR is normally done by software rather than by the peripheral, and seeds are
+// written by software too. Peripherals with other settings can be described by WordParameters directly.
+//
+// TI Tiva (TM4C) CRC module has no preset, as it has no useful default: its CRCCTRL register selects
+// the polynomial, seed, byte swapping and bit reversal, and TivaWare requires all of them to be set explicitly.
+var (
+	// STM32 is the CRC unit of STM32 microcontrollers, which is not configurable in STM32F1, F2, F4 and L1 series.
+	// It is CRC-32/MPEG-2 calculated over 32-bit words, so the CRC of word 0x12345678 is 0xDF8A8A2B.
+	STM32 = &WordParameters{CRC32MPEG2, WordMode{Size: 32, LittleEndian: true}}
+	// STM32Reflected is the CRC unit of newer STM32 series configured with word input reversal (REV_IN = 11)
+	// and output reversal (REV_OUT = 1). It is CRC-32/JAMCRC, so complementing its result gives the usual CRC-32
+	// of data.
+	STM32Reflected = &WordParameters{CRC32JAMCRC, WordMode{Size: 32, LittleEndian: true, Reflect: 32}}
+	// NXPKinetis16 is the CRC module of NXP Kinetis microcontrollers in its reset configuration (16-bit CRC,
+	// polynomial 0x1021, no transposition) seeded with 0xFFFF and fed 32-bit words. It is CRC-16/IBM-3740
+	// of words, so bytes of each word are processed in reverse order compared to their order in memory.
+	NXPKinetis16 = &WordParameters{CRC16IBM3740, WordMode{Size: 32, LittleEndian: true}}
+	// NXPKinetis32 is the CRC module of NXP Kinetis microcontrollers configured for 32-bit CRC with polynomial
+	// 0x04C11DB7, seed 0xFFFFFFFF, bits and bytes transposed on both input and output (TOT = TOTR = 10)
+	// and final XOR (FXOR = 1), fed 32-bit words. It gives the usual CRC-32 of data.
+	NXPKinetis32 = &WordParameters{CRC32, WordMode{Size: 32, LittleEndian: true, Reflect: 32}}
+	// TIMSP432CRC16 is the CRC16 part of CRC32 module of TI MSP432 (and CRC module of MSP430) microcontrollers seeded
+	// with 0xFFFF and fed 16-bit words through CRC16DIRB register, which reverses bits of each byte. Bytes are processed
+	// in memory order, so it is CRC-16/IBM-3740 of data.
+	TIMSP432CRC16 = &WordParameters{CRC16IBM3740, WordMode{Size: 16}}
+	// TIMSP432CRC32 is the CRC32 part of CRC32 module of TI MSP432 microcontrollers seeded with 0xFFFFFFFF and fed
+	// 16-bit words through CRC32DI register, which processes them least significant bit first. It is CRC-32/JAMCRC,
+	// so complementing its result gives the usual CRC-32 of data.
+	TIMSP432CRC32 = &WordParameters{CRC32JAMCRC, WordMode{Size: 16, LittleEndian: true, Reflect: 16}}
+)
+
+// WordTable calculates CRC the same way as a Table does, except input data is fed in words as described by WordMode.
+// Each word is read from data in its byte order, its bits are reversed in units of Mode.Reflect bits and then
+// it is fed into the register most significant bit first. ReflectIn of Parameters only affects how the register is
+// kept internally, so it can be set or not regardless of WordMode; ReflectOut still reverses the final value.
+//
+// If data length is not a multiple of word size, trailing bytes are fed as 8-bit words, reversed if Mode.Reflect is
+// not zero, as hardware units do for byte writes. So when feeding data in chunks, all but the last chunk must
+// consist of whole words.
+type WordTable struct {
+	table *Table
+	mode  WordMode
+}
+
+// NewWordTable creates and initializes a new WordTable for the CRC algorithm and input mode specified by wordParams.
+func NewWordTable(wordParams *WordParameters) (*WordTable, error) {
+	mode := wordParams.Mode
+	if mode.Size != 8 && mode.Size != 16 && mode.Size != 32 {
+		return nil, fmt.Errorf("%w (Size is %d)", ErrInvalidWordMode, mode.Size)
+	}
+	if mode.Reflect != 0 && mode.Reflect != 8 && mode.Reflect != 16 && mode.Reflect != 32 || mode.Reflect > mode.Size {
+		return nil, fmt.Errorf("%w (Reflect is %d)", ErrInvalidWordMode, mode.Reflect)
+	}
+	table, err := NewTableE(wordParams.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	return &WordTable{table, mode}, nil
+}
+
+// Table returns the underlying Table, which processes bytes as usual.
+func (wt *WordTable) Table() *Table {
+	return wt.table
+}
+
+// InitCrc returns a stating value for a new CRC calculation
+func (wt *WordTable) InitCrc() uint64 {
+	return wt.table.InitCrc()
+}
+
+// UpdateCrc process supplied words and updates current (partial) CRC accordingly.
+func (wt *WordTable) UpdateCrc(curValue uint64, p []byte) uint64 {
+	size := int(wt.mode.Size / 8)
+	var buf [256]byte // words in the order their bits are fed, most significant first
+	for len(p) > 0 {
+		n := 0
+		for ; n+size <= len(buf) && size <= len(p); n += size {
+			word := reflectWord(readWord(p[:size], wt.mode.LittleEndian), wt.mode.Size, wt.mode.Reflect)
+			for i := 0; i < size; i++ {
+				buf[n+i] = byte(word >> (8 * uint(size-i-1)))
+			}
+			p = p[size:]
+		}
+		if n == 0 {
+			// trailing bytes are fed as 8-bit words
+			for ; n < len(p); n++ {
+				buf[n] = byte(reflectWord(uint32(p[n]), 8, wt.mode.Reflect))
+			}
+			p = nil
+		}
+		curValue = wt.table.UpdateBits(curValue, buf[:n], 8*n, true)
+	}
+	return curValue
+}
+
+// CRC returns CRC value for the data processed so far.
+func (wt *WordTable) CRC(curValue uint64) uint64 {
+	return wt.table.CRC(curValue)
+}
+
+// CalculateCRC is a convenience function allowing to calculate CRC in one call.
+func (wt *WordTable) CalculateCRC(data []byte) uint64 {
+	return wt.CRC(wt.UpdateCrc(wt.InitCrc(), data))
+}
+
+// readWord reads a word from p, which is exactly as long as the word
+func readWord(p []byte, littleEndian bool) uint32 {
+	var word uint32
+	for i := range p {
+		if littleEndian {
+			word |= uint32(p[i]) << (8 * uint(i))
+		} else {
+			word = word<<8 | uint32(p[i])
+		}
+	}
+	return word
+}
+
+// reflectWord reverses bit order of a word of a given size in units of reflect bits, which may be 0
+// for no reversal or more than size, in which case the word is reversed as a whole.
+func reflectWord(word uint32, size, reflect uint) uint32 {
+	if reflect > size {
+		reflect = size
+	}
+	switch reflect {
+	case 8:
+		return bits.ReverseBytes32(bits.Reverse32(word))
+	case 16:
+		return bits.RotateLeft32(bits.Reverse32(word), 16)
+	case 32:
+		return bits.Reverse32(word)
+	}
+	return word
+}
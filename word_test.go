@@ -0,0 +1,125 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWordTable(t *testing.T) {
+	stm32, err := NewWordTable(STM32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crc := stm32.CalculateCRC([]byte{0x78, 0x56, 0x34, 0x12}); crc != 0xDF8A8A2B {
+		t.Errorf("Incorrect STM32 CRC 0x%08x calculated for word 0x12345678 (should be 0xdf8a8a2b)", crc)
+	}
+
+	data := []byte("STM32 CRC unit fed with words, and 3 more")
+	reflected, err := NewWordTable(STM32Reflected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crc, expected := reflected.CalculateCRC(data)^0xFFFFFFFF, CalculateCRC(CRC32, data); crc != expected {
+		t.Errorf("Incorrect complemented reflected STM32 CRC 0x%08x (should be 0x%08x)", crc, expected)
+	}
+
+	for _, preset := range []struct {
+		wordParams *WordParameters
+		params     *Parameters
+		xor        uint64
+	}{
+		{NXPKinetis32, CRC32, 0},
+		{TIMSP432CRC16, CRC16IBM3740, 0},
+		{TIMSP432CRC32, CRC32, 0xFFFFFFFF},
+	} {
+		wt, err := NewWordTable(preset.wordParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if crc, expected := wt.CalculateCRC(data)^preset.xor, CalculateCRC(preset.params, data); crc != expected {
+			t.Errorf("Incorrect %s CRC 0x%X calculated by preset %+v (should be 0x%X)", preset.params.Name, crc, preset.wordParams.Mode, expected)
+		}
+	}
+	kinetis, err := NewWordTable(NXPKinetis16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crc, expected := kinetis.CalculateCRC([]byte{0x78, 0x56, 0x34, 0x12}), CalculateCRC(CRC16IBM3740, []byte{0x12, 0x34, 0x56, 0x78}); crc != expected {
+		t.Errorf("Incorrect NXP Kinetis CRC 0x%04x calculated for word 0x12345678 (should be 0x%04x)", crc, expected)
+	}
+
+	// feeding in chunks of whole words
+	state := stm32.InitCrc()
+	state = stm32.UpdateCrc(state, data[:8])
+	state = stm32.UpdateCrc(state, data[8:])
+	if crc, expected := stm32.CRC(state), stm32.CalculateCRC(data); crc != expected {
+		t.Errorf("Incorrect STM32 CRC 0x%08x calculated in chunks (should be 0x%08x)", crc, expected)
+	}
+
+	// every mode against reference implementation feeding words bit by bit
+	for _, params := range []*Parameters{CRC32MPEG2, CRC32, CCITT, X25, CRC8SMBUS, CRC7MMC} {
+		for _, size := range []uint{8, 16, 32} {
+			for _, reflect := range []uint{0, 8, 16, 32} {
+				for _, littleEndian := range []bool{false, true} {
+					mode := WordMode{size, littleEndian, reflect}
+					wt, err := NewWordTable(&WordParameters{params, mode})
+					if reflect > size {
+						if !errors.Is(err, ErrInvalidWordMode) {
+							t.Errorf("Unexpected error %v creating WordTable for %+v (should be ErrInvalidWordMode)", err, mode)
+						}
+						continue
+					}
+					if err != nil {
+						t.Fatal(err)
+					}
+					if crc, expected := wt.CalculateCRC(data), calculateWords(params, mode, data); crc != expected {
+						t.Errorf("Incorrect %s CRC 0x%X calculated for %+v (should be 0x%X)", params.Name, crc, mode, expected)
+					}
+				}
+			}
+		}
+	}
+
+	if _, err := NewWordTable(&WordParameters{CRC32, WordMode{Size: 24}}); !errors.Is(err, ErrInvalidWordMode) {
+		t.Errorf("Unexpected error %v creating WordTable for 24-bit words (should be ErrInvalidWordMode)", err)
+	}
+}
+
+// calculateWords calculates CRC over words fed bit by bit
+func calculateWords(params *Parameters, mode WordMode, data []byte) uint64 {
+	var bits []byte
+	for len(data) > 0 {
+		size := int(mode.Size / 8)
+		if len(data) < size {
+			size = 1
+		}
+		var word []int
+		for i := 0; i < size; i++ {
+			b := data[i]
+			if mode.LittleEndian {
+				b = data[size-i-1]
+			}
+			for j := 7; j >= 0; j-- {
+				word = append(word, int(b>>uint(j))&1)
+			}
+		}
+		unit := int(mode.Reflect)
+		if unit > len(word) {
+			unit = len(word)
+		}
+		for start := 0; unit != 0 && start < len(word); start += unit {
+			for i, j := start, start+unit-1; i < j; i, j = i+1, j-1 {
+				word[i], word[j] = word[j], word[i]
+			}
+		}
+		for _, bit := range word {
+			bits = append(bits, byte(bit))
+		}
+		data = data[size:]
+	}
+	return calculateBits(params, bits)
+}
@@ -0,0 +1,66 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"io"
+	"os"
+)
+
+// UpdateZeros is same as UpdateCrc called with n zero bytes, but it takes O(log n) time rather than O(n).
+// Processing zero bytes multiplies the register by x^(8n) modulo the polynomial, and the power
+// is found by repeated squaring.
+func (t *Table) UpdateZeros(state uint64, n int64) uint64 {
+	if n <= 0 {
+		return state
+	}
+	return t.shiftState(state, uint64(n))
+}
+
+// UpdateRepeat is same as UpdateCrc called with n bytes equal to b, but it takes O(log n) time rather than O(n).
+// By linearity of CRC, the register after processing them is the register advanced over n zero bytes
+// plus the register after processing b from zero times 1 + x^8 + x^16 + ... + x^(8(n-1)).
+func (t *Table) UpdateRepeat(state uint64, b byte, n int64) uint64 {
+	if n <= 0 {
+		return state
+	}
+	width, poly := t.crcParams.Width, t.crcParams.Polynomial
+	single := t.toNormal(t.updateBytewise(0, []byte{b}))
+	repeated := mulMod(single, geomSumMod(xPowMod(8, poly, width), uint64(n), poly, width), poly, width)
+	return t.shiftState(state, uint64(n)) ^ t.fromNormal(repeated)
+}
+
+// CalculateCRCFile calculates CRC of the whole contents of f. It uses ReadAt, so current offset of f does not
+// matter, but f must support Seek. Finding holes moves the offset, so it is restored before returning.
+// On Linux holes of sparse files are found with SEEK_DATA and SEEK_HOLE and processed by UpdateZeros
+// rather than read, so gigabytes of unallocated zeros take no time. Elsewhere, or if the file system
+// does not support it, the whole file is read.
+func (t *Table) CalculateCRCFile(f *os.File) (crc uint64, err error) {
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	size := info.Size()
+	offset, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if _, seekErr := f.Seek(offset, io.SeekStart); seekErr != nil && err == nil {
+			crc, err = 0, seekErr
+		}
+	}()
+
+	crc = t.InitCrc()
+	for pos := int64(0); pos < size; {
+		start, end := nextData(f, pos, size)
+		crc = t.UpdateZeros(crc, start-pos)
+		if crc, err = t.updateRange(crc, f, start, end); err != nil {
+			return 0, err
+		}
+		pos = end
+	}
+	return t.CRC(crc), nil
+}
@@ -0,0 +1,34 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// whence values for Seek finding data and holes in sparse files, see lseek(2)
+const (
+	seekData = 3
+	seekHole = 4
+)
+
+// nextData returns the range of the next data region of f at or after pos, which ends before size.
+// The range starts at size if there is no more data, and bytes between pos and its start are zero.
+func nextData(f *os.File, pos, size int64) (start, end int64) {
+	start, err := f.Seek(pos, seekData)
+	if errors.Is(err, syscall.ENXIO) {
+		return size, size // only a hole up to the end of file
+	}
+	if err != nil || start < pos || start > size {
+		return pos, size
+	}
+	end, err = f.Seek(start, seekHole)
+	if err != nil || end <= start || end > size {
+		end = size
+	}
+	return start, end
+}
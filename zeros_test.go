@@ -0,0 +1,71 @@
+// Copyright 2016, S&K Software Development Ltd.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package crc
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUpdateZerosAndRepeat(t *testing.T) {
+	for _, entry := range catalogue {
+		params := entry.params
+		table := NewTable(params)
+		state := table.UpdateCrc(table.InitCrc(), checkInput)
+		for _, n := range []int64{0, 1, 2, 7, 100, 1000} {
+			for _, b := range []byte{0, 0x5A, 0xFF} {
+				expected := table.CRC(table.UpdateCrc(state, bytes.Repeat([]byte{b}, int(n))))
+				if crc := table.CRC(table.UpdateRepeat(state, b, n)); crc != expected {
+					t.Errorf("Incorrect %s CRC 0x%X after %d bytes 0x%02X (should be 0x%X)", params.Name, crc, n, b, expected)
+				}
+				if b != 0 {
+					continue
+				}
+				if crc := table.CRC(table.UpdateZeros(state, n)); crc != expected {
+					t.Errorf("Incorrect %s CRC 0x%X after %d zero bytes (should be 0x%X)", params.Name, crc, n, expected)
+				}
+			}
+		}
+	}
+}
+
+func TestCalculateCRCFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sparse")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	// data, a hole, more data and a hole up to the end of file
+	data := make([]byte, 8<<20)
+	copy(data, "header")
+	copy(data[3<<20:], "payload in the middle")
+	for _, offset := range []int64{0, 3 << 20} {
+		if _, err := f.WriteAt(data[offset:offset+64], offset); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := f.Truncate(int64(len(data))); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := f.Seek(12345, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	for _, params := range []*Parameters{CRC32, CCITT, CRC64XZ} {
+		table := NewTable(params)
+		crc, err := table.CalculateCRCFile(f)
+		if expected := table.CalculateCRC(data); err != nil || crc != expected {
+			t.Errorf("Incorrect %s CRC 0x%X of sparse file (should be 0x%X), error %v", params.Name, crc, expected, err)
+		}
+	}
+	if offset, err := f.Seek(0, io.SeekCurrent); err != nil || offset != 12345 {
+		t.Errorf("File offset %d not restored (should be 12345), error %v", offset, err)
+	}
+}